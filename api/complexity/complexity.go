@@ -0,0 +1,359 @@
+// Package complexity calculates query depth and complexity and rejects queries, which exceed schema limits,
+// before they are executed.
+package complexity
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/graphql-go/graphql/language/source"
+	"github.com/pkg/errors"
+)
+
+// FieldCost is a cost of field. Cost of field with selection set is multiplied together with it's children costs
+// by value of the first passed multiplier argument.
+type FieldCost struct {
+	Cost        int
+	Multipliers []string // dotted paths of arguments, which values are list sizes (e.g. `page_size`, `filter.limit`) or lists
+}
+
+type Config struct {
+	MaxDepth      int                  // zero disables depth check
+	MaxComplexity int                  // zero disables complexity check
+	DefaultCost   int                  // cost of fields, which are not listed in Fields
+	Multipliers   []string             // multipliers of fields, which are not listed in Fields
+	Fields        map[string]FieldCost // costs by `Type.field` keys
+}
+
+// Result is a computed cost of query.
+type Result struct {
+	Depth      int
+	Complexity int
+}
+
+// LimitError is returned, when query exceeds one of limits.
+type LimitError struct {
+	Limit string // depth | complexity
+	Value int
+	Max   int
+}
+
+func (e LimitError) Error() string {
+	return fmt.Sprintf("query %s %d exceeds maximum %s %d", e.Limit, e.Value, e.Limit, e.Max)
+}
+
+type resultKey struct{}
+
+// WithResult returns context with computed query cost.
+func WithResult(ctx context.Context, res Result) context.Context {
+	return context.WithValue(ctx, resultKey{}, res)
+}
+
+// FromContext returns query cost, computed by Validate. Interceptors could get it from `ctx.Params.Context`.
+func FromContext(ctx context.Context) (Result, bool) {
+	if ctx == nil {
+		return Result{}, false
+	}
+	res, ok := ctx.Value(resultKey{}).(Result)
+
+	return res, ok
+}
+
+// Validate computes query cost and returns error, if query exceeds limits.
+// Returned params context contains computed cost.
+func Validate(params graphql.Params, cfg Config) (graphql.Params, error) {
+	res, err := Analyze(params.Schema, cfg, params.RequestString, params.OperationName, params.VariableValues)
+	if err != nil {
+		return params, err
+	}
+	if cfg.MaxDepth > 0 && res.Depth > cfg.MaxDepth {
+		return params, LimitError{Limit: "depth", Value: res.Depth, Max: cfg.MaxDepth}
+	}
+	if cfg.MaxComplexity > 0 && res.Complexity > cfg.MaxComplexity {
+		return params, LimitError{Limit: "complexity", Value: res.Complexity, Max: cfg.MaxComplexity}
+	}
+	ctx := params.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	params.Context = WithResult(ctx, res)
+
+	return params, nil
+}
+
+// Analyze computes depth and complexity of query operation. Introspection fields are not counted.
+func Analyze(schema graphql.Schema, cfg Config, query, operationName string, variables map[string]interface{}) (Result, error) {
+	doc, err := parser.Parse(parser.ParseParams{
+		Source: source.NewSource(&source.Source{Body: []byte(query)}),
+	})
+	if err != nil {
+		return Result{}, errors.Wrap(err, "failed to parse query")
+	}
+	a := &analyzer{
+		schema:         schema,
+		cfg:            cfg,
+		fragments:      make(map[string]*ast.FragmentDefinition),
+		variables:      variables,
+		visiting:       make(map[string]bool),
+		fragmentsCosts: make(map[fragmentKey]fragmentCost),
+	}
+	var operation *ast.OperationDefinition
+	for _, definition := range doc.Definitions {
+		switch def := definition.(type) {
+		case *ast.FragmentDefinition:
+			a.fragments[def.Name.Value] = def
+		case *ast.OperationDefinition:
+			if operationName == "" && operation != nil {
+				return Result{}, errors.New("operation name must be provided for document with multiple operations")
+			}
+			if operationName == "" || def.Name != nil && def.Name.Value == operationName {
+				operation = def
+			}
+		}
+	}
+	if operation == nil {
+		return Result{}, errors.Errorf("operation %s not found", operationName)
+	}
+	a.variablesDefaults = make(map[string]ast.Value)
+	for _, def := range operation.VariableDefinitions {
+		if def.DefaultValue != nil {
+			a.variablesDefaults[def.Variable.Name.Value] = def.DefaultValue
+		}
+	}
+
+	var root *graphql.Object
+	switch operation.Operation {
+	case ast.OperationTypeQuery:
+		root = schema.QueryType()
+	case ast.OperationTypeMutation:
+		root = schema.MutationType()
+	case ast.OperationTypeSubscription:
+		root = schema.SubscriptionType()
+	}
+	if root == nil {
+		return Result{}, errors.Errorf("schema doesn't support %s operations", operation.Operation)
+	}
+	complexity, depth := a.selectionSet(root, operation.SelectionSet, 0)
+
+	return Result{Depth: depth, Complexity: complexity}, nil
+}
+
+type analyzer struct {
+	schema            graphql.Schema
+	cfg               Config
+	fragments         map[string]*ast.FragmentDefinition
+	variables         map[string]interface{}
+	variablesDefaults map[string]ast.Value
+	visiting          map[string]bool // fragments, which are being analyzed, to break fragments cycles
+	fragmentsCosts    map[fragmentKey]fragmentCost
+}
+
+type fragmentKey struct {
+	name   string
+	parent string // name of type, which fragment is spread on
+}
+
+type fragmentCost struct {
+	complexity int
+	depth      int // depth of fragment selections relative to spread depth
+}
+
+func (a *analyzer) selectionSet(parent graphql.Type, set *ast.SelectionSet, depth int) (complexity, maxDepth int) {
+	maxDepth = depth
+	if set == nil {
+		return 0, maxDepth
+	}
+	for _, selection := range set.Selections {
+		var selComplexity, selDepth int
+		switch sel := selection.(type) {
+		case *ast.Field:
+			if strings.HasPrefix(sel.Name.Value, "__") {
+				continue
+			}
+			selComplexity, selDepth = a.field(parent, sel, depth+1)
+		case *ast.InlineFragment:
+			selComplexity, selDepth = a.selectionSet(a.typeCondition(parent, sel.TypeCondition), sel.SelectionSet, depth)
+		case *ast.FragmentSpread:
+			selComplexity, selDepth = a.fragmentSpread(parent, sel, depth)
+		}
+		complexity = add(complexity, selComplexity)
+		if selDepth > maxDepth {
+			maxDepth = selDepth
+		}
+	}
+
+	return complexity, maxDepth
+}
+
+// fragmentSpread returns cost of spread fragment. Costs are cached by fragment and parent type, so fragments,
+// which are spread several times on each nesting level, are analyzed once instead of exponential number of times.
+func (a *analyzer) fragmentSpread(parent graphql.Type, spread *ast.FragmentSpread, depth int) (complexity, maxDepth int) {
+	name := spread.Name.Value
+	fragment, ok := a.fragments[name]
+	if !ok || a.visiting[name] {
+		return 0, depth
+	}
+	key := fragmentKey{name: name}
+	if parent != nil {
+		key.parent = parent.Name()
+	}
+	if cost, ok := a.fragmentsCosts[key]; ok {
+		return cost.complexity, depth + cost.depth
+	}
+	a.visiting[name] = true
+	complexity, maxDepth = a.selectionSet(a.typeCondition(parent, fragment.TypeCondition), fragment.SelectionSet, depth)
+	a.visiting[name] = false
+	a.fragmentsCosts[key] = fragmentCost{complexity: complexity, depth: maxDepth - depth}
+
+	return complexity, maxDepth
+}
+
+func (a *analyzer) field(parent graphql.Type, field *ast.Field, depth int) (complexity, maxDepth int) {
+	var fieldType graphql.Type
+	var parentName string
+	if parent != nil {
+		parentName = parent.Name()
+		if def := fieldDefinition(parent, field.Name.Value); def != nil {
+			fieldType, _ = graphql.GetNamed(def.Type).(graphql.Type)
+		}
+	}
+	childrenComplexity, maxDepth := a.selectionSet(fieldType, field.SelectionSet, depth)
+
+	cost, multipliers := a.cfg.DefaultCost, a.cfg.Multipliers
+	if fieldCost, ok := a.cfg.Fields[parentName+"."+field.Name.Value]; ok {
+		cost = fieldCost.Cost
+		if fieldCost.Multipliers != nil {
+			multipliers = fieldCost.Multipliers
+		}
+	}
+
+	return multiply(add(cost, childrenComplexity), a.multiplier(field.Arguments, multipliers)), maxDepth
+}
+
+func (a *analyzer) typeCondition(parent graphql.Type, condition *ast.Named) graphql.Type {
+	if condition == nil || condition.Name == nil {
+		return parent
+	}
+	if typ := a.schema.Type(condition.Name.Value); typ != nil {
+		return typ
+	}
+
+	return parent
+}
+
+// multiplier returns value of the first passed multiplier argument or it's length for list arguments.
+// Fields without multiplier arguments multiply cost by 1.
+func (a *analyzer) multiplier(args []*ast.Argument, multipliers []string) int {
+	for _, multiplier := range multipliers {
+		path := strings.Split(multiplier, ".")
+		for _, arg := range args {
+			if arg.Name.Value != path[0] {
+				continue
+			}
+			if value, ok := a.argumentInt(arg.Value, path[1:]); ok && value > 0 {
+				return value
+			}
+		}
+	}
+
+	return 1
+}
+
+func (a *analyzer) argumentInt(value ast.Value, path []string) (int, bool) {
+	switch v := value.(type) {
+	case *ast.Variable:
+		if variable, ok := a.variables[v.Name.Value]; ok {
+			return variableInt(variable, path)
+		}
+		if def, ok := a.variablesDefaults[v.Name.Value]; ok {
+			return a.argumentInt(def, path)
+		}
+	case *ast.ObjectValue:
+		if len(path) == 0 {
+			return 0, false
+		}
+		for _, field := range v.Fields {
+			if field.Name.Value == path[0] {
+				return a.argumentInt(field.Value, path[1:])
+			}
+		}
+	case *ast.ListValue:
+		if len(path) == 0 {
+			return len(v.Values), true
+		}
+	case *ast.IntValue:
+		if len(path) == 0 {
+			i, err := strconv.Atoi(v.Value)
+			return i, err == nil
+		}
+	case *ast.StringValue:
+		if len(path) == 0 {
+			i, err := strconv.Atoi(v.Value)
+			return i, err == nil
+		}
+	}
+
+	return 0, false
+}
+
+func variableInt(value interface{}, path []string) (int, bool) {
+	if len(path) > 0 {
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return 0, false
+		}
+
+		return variableInt(object[path[0]], path[1:])
+	}
+	switch v := value.(type) {
+	case []interface{}:
+		return len(v), true
+	case int:
+		return v, true
+	case int32:
+		return int(v), true
+	case int64:
+		return int(v), true
+	case float64:
+		return int(v), true
+	case string:
+		i, err := strconv.Atoi(v)
+		return i, err == nil
+	}
+
+	return 0, false
+}
+
+func fieldDefinition(parent graphql.Type, name string) *graphql.FieldDefinition {
+	switch t := parent.(type) {
+	case *graphql.Object:
+		return t.Fields()[name]
+	case *graphql.Interface:
+		return t.Fields()[name]
+	}
+
+	return nil
+}
+
+// add and multiply saturate at math.MaxInt32, so huge queries don't overflow cost.
+func add(a, b int) int {
+	if a+b > math.MaxInt32 {
+		return math.MaxInt32
+	}
+
+	return a + b
+}
+
+func multiply(a, b int) int {
+	if a > 0 && b > math.MaxInt32/a {
+		return math.MaxInt32
+	}
+
+	return a * b
+}
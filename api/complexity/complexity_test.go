@@ -0,0 +1,126 @@
+package complexity
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func testSchema() graphql.Schema {
+	user := graphql.NewObject(graphql.ObjectConfig{
+		Name: "User",
+		Fields: graphql.Fields{
+			"name": &graphql.Field{Type: graphql.String},
+		},
+	})
+	user.AddFieldConfig("friends", &graphql.Field{
+		Type: graphql.NewList(user),
+		Args: graphql.FieldConfigArgument{
+			"first": &graphql.ArgumentConfig{Type: graphql.Int},
+		},
+	})
+	filter := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "Filter",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"limit": &graphql.InputObjectFieldConfig{Type: graphql.Int},
+		},
+	})
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"users": &graphql.Field{
+					Type: graphql.NewList(user),
+					Args: graphql.FieldConfigArgument{
+						"ids":       &graphql.ArgumentConfig{Type: graphql.NewList(graphql.Int)},
+						"page_size": &graphql.ArgumentConfig{Type: graphql.Int},
+						"filter":    &graphql.ArgumentConfig{Type: filter},
+					},
+				},
+			},
+		}),
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return schema
+}
+
+func TestAnalyze(t *testing.T) {
+	Convey("Test query cost analysis", t, func() {
+		schema := testSchema()
+		cfg := Config{
+			DefaultCost: 1,
+			Multipliers: []string{"first"},
+			Fields: map[string]FieldCost{
+				"Query.users": {Cost: 10, Multipliers: []string{"page_size", "filter.limit", "ids"}},
+			},
+		}
+		analyze := func(query string, variables map[string]interface{}) Result {
+			res, err := Analyze(schema, cfg, query, "", variables)
+			So(err, ShouldBeNil)
+
+			return res
+		}
+
+		Convey("Should multiply costs by list arguments", func() {
+			// users: 5 * (10 + name 1 + friends 3 * (1 + name 1))
+			So(analyze(`{ users(page_size: 5) { name friends(first: 3) { name } } }`, nil), ShouldResemble, Result{Depth: 3, Complexity: 85})
+		})
+		Convey("Should take multipliers from variables and nested arguments", func() {
+			So(analyze(`query($f: Filter) { users(filter: $f) { name } }`, map[string]interface{}{
+				"f": map[string]interface{}{"limit": float64(4)},
+			}), ShouldResemble, Result{Depth: 2, Complexity: 44})
+			So(analyze(`query($n: Int = 2) { users(page_size: $n) { name } }`, nil), ShouldResemble, Result{Depth: 2, Complexity: 22})
+			So(analyze(`query($ids: [Int]) { a: users(ids: [1, 2, 3]) { name } b: users(ids: $ids) { name } }`, map[string]interface{}{
+				"ids": []interface{}{float64(1), float64(2)},
+			}), ShouldResemble, Result{Depth: 2, Complexity: 55})
+		})
+		Convey("Should count fragments and skip introspection fields", func() {
+			So(analyze(`{ __typename users { ...F } } fragment F on User { name ... on User { friends { name } } }`, nil),
+				ShouldResemble, Result{Depth: 3, Complexity: 13})
+		})
+		Convey("Should analyze nested fragments, spread several times, once", func() {
+			nestedFragments := func(levels int) string {
+				query := `{ users { ...F0 } }`
+				for i := 0; i < levels; i++ {
+					query += fmt.Sprintf(` fragment F%d on User { a: friends { ...F%d } b: friends { ...F%d } }`, i, i+1, i+1)
+				}
+
+				return query + fmt.Sprintf(` fragment F%d on User { name }`, levels)
+			}
+			// users: 10 + F0, Fi: 2 * (1 + Fi+1), Flevels: 1
+			So(analyze(nestedFragments(3), nil), ShouldResemble, Result{Depth: 5, Complexity: 32})
+			So(analyze(nestedFragments(64), nil), ShouldResemble, Result{Depth: 66, Complexity: math.MaxInt32})
+		})
+	})
+}
+
+func TestValidate(t *testing.T) {
+	Convey("Test query validation", t, func() {
+		params := graphql.Params{
+			Schema:        testSchema(),
+			RequestString: `{ users(page_size: 100) { friends(first: 100) { name } } }`,
+		}
+
+		Convey("Should reject too deep queries", func() {
+			_, err := Validate(params, Config{MaxDepth: 2})
+			So(err, ShouldResemble, LimitError{Limit: "depth", Value: 3, Max: 2})
+		})
+		Convey("Should reject too complex queries", func() {
+			_, err := Validate(params, Config{MaxComplexity: 1000, DefaultCost: 1, Multipliers: []string{"page_size", "first"}})
+			So(err, ShouldResemble, LimitError{Limit: "complexity", Value: 20100, Max: 1000})
+		})
+		Convey("Should put cost to context", func() {
+			params, err := Validate(params, Config{MaxDepth: 3, MaxComplexity: 1000, DefaultCost: 1})
+			So(err, ShouldBeNil)
+			res, ok := FromContext(params.Context)
+			So(ok, ShouldBeTrue)
+			So(res, ShouldResemble, Result{Depth: 3, Complexity: 3})
+		})
+	})
+}
@@ -0,0 +1,65 @@
+package dataloader
+
+import (
+	"sync"
+	"time"
+)
+
+// Batcher collects keys, requested during wait duration, and fetches them together.
+// Batcher has no cache: every requested key gets own fetched value, even if same key is requested several times.
+type Batcher struct {
+	wait  time.Duration
+	fetch func(keys []interface{}) ([]interface{}, []error)
+
+	mu    sync.Mutex
+	batch *batcherBatch
+}
+
+type batcherBatch struct {
+	keys   []interface{}
+	values []interface{}
+	errs   []error
+	done   chan struct{}
+}
+
+func NewBatcher(wait time.Duration, fetch func(keys []interface{}) ([]interface{}, []error)) *Batcher {
+	return &Batcher{
+		wait:  wait,
+		fetch: fetch,
+	}
+}
+
+// LoadThunk adds key to current batch and returns function, which waits for batch to be fetched.
+func (b *Batcher) LoadThunk(key interface{}) func() (interface{}, error) {
+	b.mu.Lock()
+	if b.batch == nil {
+		b.batch = &batcherBatch{done: make(chan struct{})}
+		go b.end(b.batch)
+	}
+	batch := b.batch
+	index := len(batch.keys)
+	batch.keys = append(batch.keys, key)
+	b.mu.Unlock()
+
+	return func() (interface{}, error) {
+		<-batch.done
+
+		var value interface{}
+		if index < len(batch.values) {
+			value = batch.values[index]
+		}
+
+		return value, ErrorAt(batch.errs, index)
+	}
+}
+
+func (b *Batcher) end(batch *batcherBatch) {
+	time.Sleep(b.wait)
+
+	b.mu.Lock()
+	b.batch = nil
+	b.mu.Unlock()
+
+	batch.values, batch.errs = b.fetch(batch.keys)
+	close(batch.done)
+}
@@ -0,0 +1,66 @@
+package dataloader
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestBatcher(t *testing.T) {
+	Convey("Test Batcher", t, func() {
+		var mu sync.Mutex
+		var batches [][]interface{}
+		batcher := NewBatcher(time.Millisecond, func(keys []interface{}) ([]interface{}, []error) {
+			mu.Lock()
+			batches = append(batches, keys)
+			mu.Unlock()
+			values := make([]interface{}, len(keys))
+			for i, key := range keys {
+				values[i] = &struct{ Key interface{} }{Key: key}
+			}
+
+			return values, nil
+		})
+		Convey("Should fetch keys together and not share values of same keys", func() {
+			first := batcher.LoadThunk(1)
+			second := batcher.LoadThunk(1)
+			third := batcher.LoadThunk(2)
+			firstValue, err := first()
+			So(err, ShouldBeNil)
+			secondValue, err := second()
+			So(err, ShouldBeNil)
+			thirdValue, err := third()
+			So(err, ShouldBeNil)
+			So(batches, ShouldResemble, [][]interface{}{{1, 1, 2}})
+			So(firstValue, ShouldResemble, &struct{ Key interface{} }{Key: 1})
+			So(secondValue, ShouldResemble, firstValue)
+			So(secondValue, ShouldNotPointTo, firstValue)
+			So(thirdValue, ShouldResemble, &struct{ Key interface{} }{Key: 2})
+		})
+		Convey("Should start new batch after fetch", func() {
+			_, err := batcher.LoadThunk(1)()
+			So(err, ShouldBeNil)
+			_, err = batcher.LoadThunk(2)()
+			So(err, ShouldBeNil)
+			So(batches, ShouldResemble, [][]interface{}{{1}, {2}})
+		})
+	})
+	Convey("Test Batcher errors", t, func() {
+		fetchErr := errors.New("fetch error")
+		batcher := NewBatcher(time.Millisecond, func(keys []interface{}) ([]interface{}, []error) {
+			return nil, []error{fetchErr}
+		})
+		Convey("Should return fetch error for every key", func() {
+			first := batcher.LoadThunk(1)
+			second := batcher.LoadThunk(2)
+			value, err := first()
+			So(value, ShouldBeNil)
+			So(err, ShouldEqual, fetchErr)
+			_, err = second()
+			So(err, ShouldEqual, fetchErr)
+		})
+	})
+}
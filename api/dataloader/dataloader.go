@@ -0,0 +1,114 @@
+package dataloader
+
+import (
+	"strconv"
+	"sync"
+	"time"
+)
+
+// ErrorAt returns error of key with given index from data loader fetch errors.
+// Single error is related to all keys.
+func ErrorAt(errs []error, i int) error {
+	if len(errs) == 1 {
+		return errs[0]
+	}
+	if i < len(errs) {
+		return errs[i]
+	}
+
+	return nil
+}
+
+// ParseInt parses data loader int key from string. The first parse error is stored to err.
+func ParseInt(s string, bitSize int, err *error) int64 {
+	value, parseErr := strconv.ParseInt(s, 10, bitSize)
+	setParseError(err, parseErr)
+
+	return value
+}
+
+// ParseUint parses data loader uint key from string. The first parse error is stored to err.
+func ParseUint(s string, bitSize int, err *error) uint64 {
+	value, parseErr := strconv.ParseUint(s, 10, bitSize)
+	setParseError(err, parseErr)
+
+	return value
+}
+
+// ParseFloat parses data loader float key from string. The first parse error is stored to err.
+func ParseFloat(s string, bitSize int, err *error) float64 {
+	value, parseErr := strconv.ParseFloat(s, bitSize)
+	setParseError(err, parseErr)
+
+	return value
+}
+
+func setParseError(err *error, parseErr error) {
+	if parseErr != nil && *err == nil {
+		*err = parseErr
+	}
+}
+
+type ttlCacheItem struct {
+	value     interface{}
+	expiresAt time.Time
+}
+
+// TTLCache is a data loader cache, which is shared between requests and expires values after ttl.
+type TTLCache struct {
+	ttl       time.Duration
+	mu        sync.Mutex
+	items     map[interface{}]ttlCacheItem
+	lastPurge time.Time
+}
+
+func NewTTLCache(ttl time.Duration) *TTLCache {
+	return &TTLCache{
+		ttl:       ttl,
+		items:     make(map[interface{}]ttlCacheItem),
+		lastPurge: time.Now(),
+	}
+}
+
+func (c *TTLCache) Get(key interface{}) (interface{}, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	item, ok := c.items[key]
+	if !ok {
+		return nil, false
+	}
+	if time.Now().After(item.expiresAt) {
+		delete(c.items, key)
+
+		return nil, false
+	}
+
+	return item.value, true
+}
+
+func (c *TTLCache) Set(key, value interface{}) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	if now.Sub(c.lastPurge) > c.ttl {
+		for k, item := range c.items {
+			if now.After(item.expiresAt) {
+				delete(c.items, k)
+			}
+		}
+		c.lastPurge = now
+	}
+	c.items[key] = ttlCacheItem{
+		value:     value,
+		expiresAt: now.Add(c.ttl),
+	}
+}
+
+func (c *TTLCache) Clear(key interface{}) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.items, key)
+}
@@ -0,0 +1,30 @@
+package dataloader
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestParseKeys(t *testing.T) {
+	Convey("Test keys parsing", t, func() {
+		var err error
+		Convey("Should parse valid keys", func() {
+			So(ParseInt("-12", 32, &err), ShouldEqual, -12)
+			So(ParseUint("12", 0, &err), ShouldEqual, 12)
+			So(ParseFloat("1.5", 64, &err), ShouldEqual, 1.5)
+			So(err, ShouldBeNil)
+		})
+		Convey("Should store the first parse error", func() {
+			ParseInt("abc", 64, &err)
+			So(err, ShouldNotBeNil)
+			firstErr := err
+			ParseUint("-1", 64, &err)
+			So(err, ShouldEqual, firstErr)
+		})
+		Convey("Should fail on out of range keys", func() {
+			ParseInt("128", 8, &err)
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
@@ -0,0 +1,163 @@
+// Package enums resolves enums values, which are unknown to generated schema. E.g. values, which were added
+// to backend enums before gateway was regenerated.
+package enums
+
+import (
+	"context"
+	"sync"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/pkg/errors"
+)
+
+// ExtensionName is a response extensions key of unknown values reports.
+const ExtensionName = "unknownEnumValues"
+
+type unknown struct{}
+
+// Unknown is a value of enums fallback value. Values, which are unknown to enum, are resolved to it.
+var Unknown = unknown{}
+
+// Value returns value, if enum has it, or Unknown otherwise.
+func Value(enum *graphql.Enum, value int) interface{} {
+	if enum.Serialize(value) == nil {
+		return Unknown
+	}
+
+	return value
+}
+
+// Values maps values, which are unknown to enum, to Unknown.
+func Values(enum *graphql.Enum, values []int) []interface{} {
+	res := make([]interface{}, len(values))
+	for i, value := range values {
+		res[i] = Value(enum, value)
+	}
+
+	return res
+}
+
+// Input returns int value of enum argument. Fallback value can't be passed to backends, so it's rejected.
+func Input(value interface{}) (int, error) {
+	if value == Unknown {
+		return 0, errors.New("unknown enum value can't be used as input")
+	}
+	res, ok := value.(int)
+	if !ok {
+		return 0, errors.Errorf("unexpected enum value type %T", value)
+	}
+
+	return res, nil
+}
+
+// UnknownValue is a report of value, which is unknown to enum.
+type UnknownValue struct {
+	Enum  string        `json:"enum"`
+	Value int           `json:"value"`
+	Path  []interface{} `json:"path"`
+}
+
+type reportKey struct{}
+
+type report struct {
+	mu     sync.Mutex
+	values []UnknownValue
+}
+
+// Report returns value as is and, if enum has no such value, reports it to request Extension.
+// Unknown value is still serialized as null.
+func Report(p graphql.ResolveParams, enum *graphql.Enum, value int) interface{} {
+	if enum.Serialize(value) == nil {
+		reportValue(p, enum, value, nil)
+	}
+
+	return value
+}
+
+// ReportValues is the same as Report, but for lists. Paths of reported values contain list indexes.
+func ReportValues(p graphql.ResolveParams, enum *graphql.Enum, values []int) []int {
+	for i, value := range values {
+		if enum.Serialize(value) == nil {
+			reportValue(p, enum, value, i)
+		}
+	}
+
+	return values
+}
+
+func reportValue(p graphql.ResolveParams, enum *graphql.Enum, value int, index interface{}) {
+	if p.Context == nil {
+		return
+	}
+	r, ok := p.Context.Value(reportKey{}).(*report)
+	if !ok {
+		return
+	}
+	path := p.Info.Path
+	if index != nil {
+		path = path.WithKey(index)
+	}
+	r.mu.Lock()
+	r.values = append(r.values, UnknownValue{
+		Enum:  enum.Name(),
+		Value: value,
+		Path:  path.AsArray(),
+	})
+	r.mu.Unlock()
+}
+
+// Extension adds reports of unknown enums values to `unknownEnumValues` response extension.
+// Responses without unknown values have no such extension. It should be added to schema with schema.AddExtensions.
+type Extension struct{}
+
+func (Extension) Init(ctx context.Context, _ *graphql.Params) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	return context.WithValue(ctx, reportKey{}, &report{})
+}
+
+func (Extension) Name() string {
+	return ExtensionName
+}
+
+func (Extension) ParseDidStart(ctx context.Context) (context.Context, graphql.ParseFinishFunc) {
+	return ctx, func(error) {}
+}
+
+func (Extension) ValidationDidStart(ctx context.Context) (context.Context, graphql.ValidationFinishFunc) {
+	return ctx, func([]gqlerrors.FormattedError) {}
+}
+
+func (Extension) ExecutionDidStart(ctx context.Context) (context.Context, graphql.ExecutionFinishFunc) {
+	return ctx, func(result *graphql.Result) {
+		r, ok := ctx.Value(reportKey{}).(*report)
+		if !ok || result == nil {
+			return
+		}
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		if len(r.values) == 0 {
+			return
+		}
+		if result.Extensions == nil {
+			result.Extensions = make(map[string]interface{})
+		}
+		result.Extensions[ExtensionName] = r.values
+	}
+}
+
+func (Extension) ResolveFieldDidStart(ctx context.Context, _ *graphql.ResolveInfo) (context.Context, graphql.ResolveFieldFinishFunc) {
+	return ctx, func(interface{}, error) {}
+}
+
+// HasResult returns false, because reports are added to result, when execution is finished.
+func (Extension) HasResult() bool {
+	return false
+}
+
+func (Extension) GetResult(context.Context) interface{} {
+	return nil
+}
@@ -0,0 +1,128 @@
+package enums
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func testSchema(fallback bool) (graphql.Schema, error) {
+	values := graphql.EnumValueConfigMap{
+		"ACTIVE":  &graphql.EnumValueConfig{Value: 1},
+		"DELETED": &graphql.EnumValueConfig{Value: 2},
+	}
+	if fallback {
+		values["UNKNOWN"] = &graphql.EnumValueConfig{Value: Unknown}
+	}
+	status := graphql.NewEnum(graphql.EnumConfig{
+		Name:   "Status",
+		Values: values,
+	})
+	statusResolver := func(p graphql.ResolveParams) (interface{}, error) {
+		if fallback {
+			return Value(status, 3), nil
+		}
+
+		return Report(p, status, 3), nil
+	}
+	statusesResolver := func(p graphql.ResolveParams) (interface{}, error) {
+		if fallback {
+			return Values(status, []int{1, 4}), nil
+		}
+
+		return ReportValues(p, status, []int{1, 4}), nil
+	}
+
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"status": &graphql.Field{
+					Type:    status,
+					Resolve: statusResolver,
+				},
+				"statuses": &graphql.Field{
+					Type:    graphql.NewList(status),
+					Resolve: statusesResolver,
+				},
+				"known": &graphql.Field{
+					Type: status,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return Report(p, status, 2), nil
+					},
+				},
+				"filter": &graphql.Field{
+					Type: graphql.Int,
+					Args: graphql.FieldConfigArgument{
+						"status": &graphql.ArgumentConfig{Type: status},
+					},
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return Input(p.Args["status"])
+					},
+				},
+			},
+		}),
+	})
+	if err != nil {
+		return schema, err
+	}
+	schema.AddExtensions(Extension{})
+
+	return schema, nil
+}
+
+func TestFallback(t *testing.T) {
+	Convey("Test unknown enums values fallback", t, func() {
+		schema, err := testSchema(true)
+		So(err, ShouldBeNil)
+
+		Convey("Unknown values are resolved to fallback value", func() {
+			res := graphql.Do(graphql.Params{Schema: schema, RequestString: `{ status statuses known }`})
+			So(res.Errors, ShouldBeEmpty)
+			So(res.Data, ShouldResemble, map[string]interface{}{
+				"status":   "UNKNOWN",
+				"statuses": []interface{}{"ACTIVE", "UNKNOWN"},
+				"known":    "DELETED",
+			})
+			So(res.Extensions, ShouldBeNil)
+		})
+		Convey("Known values are passed as inputs", func() {
+			res := graphql.Do(graphql.Params{Schema: schema, RequestString: `{ filter(status: DELETED) }`})
+			So(res.Errors, ShouldBeEmpty)
+			So(res.Data, ShouldResemble, map[string]interface{}{"filter": 2})
+		})
+		Convey("Fallback value is rejected as input", func() {
+			res := graphql.Do(graphql.Params{Schema: schema, RequestString: `{ filter(status: UNKNOWN) }`})
+			So(res.Errors, ShouldHaveLength, 1)
+			So(res.Errors[0].Message, ShouldEqual, "unknown enum value can't be used as input")
+		})
+	})
+}
+
+func TestReport(t *testing.T) {
+	Convey("Test unknown enums values reports", t, func() {
+		schema, err := testSchema(false)
+		So(err, ShouldBeNil)
+
+		Convey("Unknown values are reported to extension", func() {
+			res := graphql.Do(graphql.Params{Schema: schema, RequestString: `{ status statuses known }`})
+			So(res.Errors, ShouldBeEmpty)
+			So(res.Data, ShouldResemble, map[string]interface{}{
+				"status":   nil,
+				"statuses": []interface{}{"ACTIVE", nil},
+				"known":    "DELETED",
+			})
+			values, ok := res.Extensions[ExtensionName].([]UnknownValue)
+			So(ok, ShouldBeTrue)
+			So(values, ShouldHaveLength, 2)
+			So(values, ShouldContain, UnknownValue{Enum: "Status", Value: 3, Path: []interface{}{"status"}})
+			So(values, ShouldContain, UnknownValue{Enum: "Status", Value: 4, Path: []interface{}{"statuses", 1}})
+		})
+		Convey("Responses without unknown values have no extension", func() {
+			res := graphql.Do(graphql.Params{Schema: schema, RequestString: `{ known }`})
+			So(res.Errors, ShouldBeEmpty)
+			So(res.Extensions, ShouldBeNil)
+		})
+	})
+}
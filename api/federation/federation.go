@@ -0,0 +1,352 @@
+// Package federation implements Apollo Federation subgraph specification for generated schemas:
+// `_Any`, `_Service` and `_Entity` types, `_service` and `_entities` query fields and schema SDL with `@key` directives.
+package federation
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/pkg/errors"
+)
+
+const (
+	ServiceFieldName  = "_service"
+	EntitiesFieldName = "_entities"
+
+	typenameKey = "__typename"
+)
+
+// ReferenceResolver resolves entity by it's representation, sent by gateway. Representation contains `__typename`
+// and `@key` fields values. Resolver may return `func() (interface{}, error)` thunk to resolve entity later.
+type ReferenceResolver func(p graphql.ResolveParams, representation map[string]interface{}) (interface{}, error)
+
+// Entity is an object, which could be referenced by other services and resolved with `_entities` query.
+type Entity struct {
+	Object           *graphql.Object
+	Keys             []string                     // fields sets of `@key` directives
+	IsTypeOf         func(value interface{}) bool // checks, if resolved value belongs to entity object
+	ResolveReference ReferenceResolver
+}
+
+type Config struct {
+	Entities []Entity
+}
+
+var AnyScalar = graphql.NewScalar(graphql.ScalarConfig{
+	Name:        "_Any",
+	Description: "Entity representation, which contains `__typename` and `@key` fields",
+	Serialize: func(value interface{}) interface{} {
+		return value
+	},
+	ParseValue: func(value interface{}) interface{} {
+		return value
+	},
+	ParseLiteral: parseLiteral,
+})
+
+var ServiceObject = graphql.NewObject(graphql.ObjectConfig{
+	Name: "_Service",
+	Fields: graphql.Fields{
+		"sdl": &graphql.Field{
+			Name: "sdl",
+			Type: graphql.NewNonNull(graphql.String),
+		},
+	},
+})
+
+// NewSchema adds federation types and fields to query object of schema config and creates schema.
+func NewSchema(schemaCfg graphql.SchemaConfig, cfg Config) (graphql.Schema, error) {
+	if schemaCfg.Query == nil {
+		return graphql.Schema{}, errors.New("federated schema must have query object")
+	}
+	for i, entity := range cfg.Entities {
+		if entity.Object == nil {
+			// e.g. object is pruned, because none of its fields is visible.
+			return graphql.Schema{}, errors.Errorf("entity %d object is nil", i)
+		}
+	}
+
+	var sdl string
+	schemaCfg.Query.AddFieldConfig(ServiceFieldName, &graphql.Field{
+		Name: ServiceFieldName,
+		Type: graphql.NewNonNull(ServiceObject),
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			return map[string]interface{}{"sdl": sdl}, nil
+		},
+	})
+
+	if len(cfg.Entities) > 0 {
+		entities := make(map[string]Entity, len(cfg.Entities))
+		objects := make([]*graphql.Object, 0, len(cfg.Entities))
+		for _, entity := range cfg.Entities {
+			if _, ok := entities[entity.Object.Name()]; ok {
+				return graphql.Schema{}, errors.Errorf("entity %s is duplicated", entity.Object.Name())
+			}
+			entities[entity.Object.Name()] = entity
+			objects = append(objects, entity.Object)
+			schemaCfg.Types = append(schemaCfg.Types, entity.Object)
+		}
+
+		entityUnion := graphql.NewUnion(graphql.UnionConfig{
+			Name:  "_Entity",
+			Types: objects,
+			ResolveType: func(p graphql.ResolveTypeParams) *graphql.Object {
+				for _, entity := range cfg.Entities {
+					if entity.IsTypeOf(p.Value) {
+						return entity.Object
+					}
+				}
+
+				return nil
+			},
+		})
+
+		schemaCfg.Query.AddFieldConfig(EntitiesFieldName, &graphql.Field{
+			Name: EntitiesFieldName,
+			Type: graphql.NewNonNull(graphql.NewList(entityUnion)),
+			Args: graphql.FieldConfigArgument{
+				"representations": &graphql.ArgumentConfig{
+					Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(AnyScalar))),
+				},
+			},
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return resolveEntities(p, entities)
+			},
+		})
+	}
+
+	schema, err := graphql.NewSchema(schemaCfg)
+	if err != nil {
+		return graphql.Schema{}, err
+	}
+	sdl = PrintSchema(schema, cfg)
+
+	return schema, nil
+}
+
+// resolveEntities resolves representations in the same order. Errors are returned per entity,
+// so one failed reference doesn't fail the whole query.
+func resolveEntities(p graphql.ResolveParams, entities map[string]Entity) (interface{}, error) {
+	representations, ok := p.Args["representations"].([]interface{})
+	if !ok {
+		return nil, errors.New("representations must be a list")
+	}
+	res := make([]interface{}, len(representations))
+	for i, value := range representations {
+		entity, err := resolveEntity(p, entities, value)
+		if err != nil {
+			err := errors.Wrapf(err, "failed to resolve representation %d", i)
+			res[i] = func() (interface{}, error) {
+				return nil, err
+			}
+
+			continue
+		}
+		res[i] = entity
+	}
+
+	return res, nil
+}
+
+func resolveEntity(p graphql.ResolveParams, entities map[string]Entity, value interface{}) (interface{}, error) {
+	representation, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, errors.Errorf("representation must be an object, got %T", value)
+	}
+	typename, ok := representation[typenameKey].(string)
+	if !ok {
+		return nil, errors.Errorf("representation must contain %s", typenameKey)
+	}
+	entity, ok := entities[typename]
+	if !ok {
+		return nil, errors.Errorf("entity %s not found", typename)
+	}
+
+	return entity.ResolveReference(p, representation)
+}
+
+// FieldReferenceResolver returns resolver, which resolves references with query field.
+// Representation fields are passed as field arguments with the same names.
+func FieldReferenceResolver(field *graphql.Field) ReferenceResolver {
+	return func(p graphql.ResolveParams, representation map[string]interface{}) (interface{}, error) {
+		args := make(map[string]interface{})
+		for name, arg := range field.Args {
+			value, ok := representation[name]
+			if !ok {
+				continue
+			}
+			coerced, err := coerceInput(arg.Type, value)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid representation field %s", name)
+			}
+			args[name] = coerced
+		}
+		p.Args = args
+
+		return field.Resolve(p)
+	}
+}
+
+// ReferenceKey sets value of representation field to key. key must be a pointer to scalar value.
+// Numbers could be passed as strings, because `ID` and `Int64` fields are serialized as strings.
+func ReferenceKey(representation map[string]interface{}, field string, key interface{}) error {
+	value, ok := representation[field]
+	if !ok || value == nil {
+		return errors.Errorf("representation field %s is missing", field)
+	}
+	keyValue := reflect.ValueOf(key)
+	if keyValue.Kind() != reflect.Ptr || keyValue.IsNil() {
+		return errors.Errorf("key must be a non-nil pointer, got %T", key)
+	}
+	if err := setKey(keyValue.Elem(), value); err != nil {
+		return errors.Wrapf(err, "invalid representation field %s", field)
+	}
+
+	return nil
+}
+
+func setKey(key reflect.Value, value interface{}) error {
+	str := fmt.Sprint(value)
+	if f, ok := value.(float64); ok {
+		// JSON numbers are decoded as float64
+		str = strconv.FormatFloat(f, 'f', -1, 64)
+	}
+	switch key.Kind() {
+	case reflect.String:
+		key.SetString(str)
+	case reflect.Bool:
+		v, err := strconv.ParseBool(str)
+		if err != nil {
+			return err
+		}
+		key.SetBool(v)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v, err := strconv.ParseInt(str, 10, key.Type().Bits())
+		if err != nil {
+			return err
+		}
+		key.SetInt(v)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		v, err := strconv.ParseUint(str, 10, key.Type().Bits())
+		if err != nil {
+			return err
+		}
+		key.SetUint(v)
+	case reflect.Float32, reflect.Float64:
+		v, err := strconv.ParseFloat(str, key.Type().Bits())
+		if err != nil {
+			return err
+		}
+		key.SetFloat(v)
+	default:
+		return errors.Errorf("key type %s is not supported", key.Type())
+	}
+
+	return nil
+}
+
+// coerceInput converts representation value, decoded from JSON, to value of input type,
+// the same way as graphql-go does for arguments.
+func coerceInput(typ graphql.Input, value interface{}) (interface{}, error) {
+	if value == nil {
+		if _, ok := typ.(*graphql.NonNull); ok {
+			return nil, errors.New("value must not be null")
+		}
+
+		return nil, nil
+	}
+	switch t := typ.(type) {
+	case *graphql.NonNull:
+		return coerceInput(t.OfType, value)
+	case *graphql.List:
+		values, ok := value.([]interface{})
+		if !ok {
+			values = []interface{}{value}
+		}
+		res := make([]interface{}, len(values))
+		for i, v := range values {
+			coerced, err := coerceInput(t.OfType, v)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid list item %d", i)
+			}
+			res[i] = coerced
+		}
+
+		return res, nil
+	case *graphql.InputObject:
+		values, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, errors.Errorf("value of %s must be an object", t.Name())
+		}
+		res := make(map[string]interface{})
+		for name, field := range t.Fields() {
+			v, ok := values[name]
+			if !ok {
+				continue
+			}
+			coerced, err := coerceInput(field.Type, v)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid field %s", name)
+			}
+			res[name] = coerced
+		}
+
+		return res, nil
+	case *graphql.Scalar:
+		return parseValue(t.Name(), t.ParseValue(value))
+	case *graphql.Enum:
+		return parseValue(t.Name(), t.ParseValue(value))
+	}
+
+	return nil, errors.Errorf("input type %s is not supported", typ)
+}
+
+func parseValue(typ string, value interface{}) (interface{}, error) {
+	if value == nil {
+		return nil, errors.Errorf("value is not a valid %s", typ)
+	}
+
+	return value, nil
+}
+
+func parseLiteral(valueAST ast.Value) interface{} {
+	switch v := valueAST.(type) {
+	case *ast.ObjectValue:
+		res := make(map[string]interface{}, len(v.Fields))
+		for _, field := range v.Fields {
+			res[field.Name.Value] = parseLiteral(field.Value)
+		}
+
+		return res
+	case *ast.ListValue:
+		res := make([]interface{}, len(v.Values))
+		for i, value := range v.Values {
+			res[i] = parseLiteral(value)
+		}
+
+		return res
+	case *ast.IntValue:
+		if i, err := strconv.ParseInt(v.Value, 10, 64); err == nil {
+			return i
+		}
+
+		return v.Value
+	case *ast.FloatValue:
+		if f, err := strconv.ParseFloat(v.Value, 64); err == nil {
+			return f
+		}
+
+		return v.Value
+	case *ast.StringValue:
+		return v.Value
+	case *ast.BooleanValue:
+		return v.Value
+	case *ast.EnumValue:
+		return v.Value
+	}
+
+	return nil
+}
@@ -0,0 +1,240 @@
+package federation
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/pkg/errors"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+type user struct {
+	ID   int64
+	Name string
+}
+
+func testSchema() (graphql.Schema, error) {
+	userObject := graphql.NewObject(graphql.ObjectConfig{
+		Name:        "User",
+		Description: "User of service",
+		Fields: graphql.Fields{
+			"id": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.ID),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return p.Source.(*user).ID, nil
+				},
+			},
+			"name": &graphql.Field{
+				Type: graphql.String,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return p.Source.(*user).Name, nil
+				},
+			},
+		},
+	})
+	getUser := &graphql.Field{
+		Name: "getUser",
+		Type: userObject,
+		Args: graphql.FieldConfigArgument{
+			"id": &graphql.ArgumentConfig{Type: graphql.Int},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			id := p.Args["id"].(int)
+			if id == 0 {
+				return nil, errors.New("user not found")
+			}
+
+			return &user{ID: int64(id), Name: "user"}, nil
+		},
+	}
+	query := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"getUser": getUser,
+		},
+	})
+
+	return NewSchema(graphql.SchemaConfig{Query: query}, Config{
+		Entities: []Entity{
+			{
+				Object: userObject,
+				Keys:   []string{"id"},
+				IsTypeOf: func(value interface{}) bool {
+					_, ok := value.(*user)
+					return ok
+				},
+				ResolveReference: FieldReferenceResolver(getUser),
+			},
+		},
+	})
+}
+
+func TestNewSchema(t *testing.T) {
+	Convey("Test federated schema", t, func() {
+		schema, err := testSchema()
+		So(err, ShouldBeNil)
+
+		Convey("Should return SDL with @key directives", func() {
+			res := graphql.Do(graphql.Params{
+				Schema:        schema,
+				RequestString: `{ _service { sdl } }`,
+			})
+			So(res.Errors, ShouldBeEmpty)
+			sdl := res.Data.(map[string]interface{})["_service"].(map[string]interface{})["sdl"]
+			So(sdl, ShouldEqual, `type Query {
+  getUser(id: Int): User
+}
+
+"User of service"
+type User @key(fields: "id") {
+  id: ID!
+  name: String
+}
+`)
+		})
+		Convey("Should resolve entities from literal and variables representations", func() {
+			res := graphql.Do(graphql.Params{
+				Schema:        schema,
+				RequestString: `query($r: [_Any!]!) { a: _entities(representations: [{__typename: "User", id: 1}]) { ... on User { id name } } b: _entities(representations: $r) { ... on User { id } } }`,
+				VariableValues: map[string]interface{}{
+					"r": []interface{}{
+						map[string]interface{}{"__typename": "User", "id": float64(2)},
+						map[string]interface{}{"__typename": "User", "id": float64(0)},
+						map[string]interface{}{"__typename": "Unknown"},
+					},
+				},
+			})
+			So(res.Data, ShouldResemble, map[string]interface{}{
+				"a": []interface{}{
+					map[string]interface{}{"id": "1", "name": "user"},
+				},
+				"b": []interface{}{
+					map[string]interface{}{"id": "2"},
+					nil,
+					nil,
+				},
+			})
+			So(res.Errors, ShouldHaveLength, 2)
+		})
+	})
+}
+
+func TestReferenceKey(t *testing.T) {
+	Convey("Test ReferenceKey", t, func() {
+		representation := map[string]interface{}{
+			"float":  float64(10),
+			"string": "11",
+			"bool":   true,
+		}
+		var i int64
+		So(ReferenceKey(representation, "float", &i), ShouldBeNil)
+		So(i, ShouldEqual, 10)
+		So(ReferenceKey(representation, "string", &i), ShouldBeNil)
+		So(i, ShouldEqual, 11)
+		var u uint32
+		So(ReferenceKey(representation, "string", &u), ShouldBeNil)
+		So(u, ShouldEqual, 11)
+		var s string
+		So(ReferenceKey(representation, "float", &s), ShouldBeNil)
+		So(s, ShouldEqual, "10")
+		var b bool
+		So(ReferenceKey(representation, "bool", &b), ShouldBeNil)
+		So(b, ShouldBeTrue)
+		So(ReferenceKey(representation, "bool", &i), ShouldNotBeNil)
+		So(ReferenceKey(representation, "missing", &i), ShouldNotBeNil)
+	})
+}
+
+func TestPrintSchema(t *testing.T) {
+	Convey("Test PrintSchema", t, func() {
+		status := graphql.NewEnum(graphql.EnumConfig{
+			Name: "Status",
+			Values: graphql.EnumValueConfigMap{
+				"ACTIVE":  &graphql.EnumValueConfig{Value: 1},
+				"BLOCKED": &graphql.EnumValueConfig{Value: 2},
+			},
+		})
+		filter := graphql.NewInputObject(graphql.InputObjectConfig{
+			Name: "Filter",
+			Fields: graphql.InputObjectConfigFieldMap{
+				"status": &graphql.InputObjectFieldConfig{Type: status, DefaultValue: 2},
+				"limit":  &graphql.InputObjectFieldConfig{Type: graphql.Int},
+				"name":   &graphql.InputObjectFieldConfig{Type: graphql.String},
+			},
+		})
+		users := &graphql.Field{
+			Type: graphql.String,
+			Args: graphql.FieldConfigArgument{
+				"status":   &graphql.ArgumentConfig{Type: status, DefaultValue: 1},
+				"statuses": &graphql.ArgumentConfig{Type: graphql.NewList(status), DefaultValue: []interface{}{1, 2}},
+				"filter": &graphql.ArgumentConfig{Type: filter, DefaultValue: map[string]interface{}{
+					"status": 1,
+					"limit":  10,
+					"name":   `a "b"`,
+				}},
+			},
+		}
+		service := &graphql.Field{Type: graphql.String}
+
+		Convey("Should print enums and input objects defaults as GraphQL literals", func() {
+			schema, err := graphql.NewSchema(graphql.SchemaConfig{
+				Query: graphql.NewObject(graphql.ObjectConfig{
+					Name:   "Query",
+					Fields: graphql.Fields{"users": users, ServiceFieldName: service},
+				}),
+			})
+			So(err, ShouldBeNil)
+			So(PrintSchema(schema, Config{}), ShouldEqual, `input Filter {
+  limit: Int
+  name: String
+  status: Status = BLOCKED
+}
+
+type Query {
+  users(filter: Filter = {limit: 10, name: "a \"b\"", status: ACTIVE}, status: Status = ACTIVE, statuses: [Status] = [ACTIVE, BLOCKED]): String
+}
+
+enum Status {
+  ACTIVE
+  BLOCKED
+}
+`)
+		})
+		Convey("Should not print query with federation fields only", func() {
+			schema, err := graphql.NewSchema(graphql.SchemaConfig{
+				Query: graphql.NewObject(graphql.ObjectConfig{
+					Name:   "RootQuery",
+					Fields: graphql.Fields{ServiceFieldName: service},
+				}),
+				Mutation: graphql.NewObject(graphql.ObjectConfig{
+					Name:   "Mutation",
+					Fields: graphql.Fields{"block": &graphql.Field{Type: status}},
+				}),
+			})
+			So(err, ShouldBeNil)
+			So(PrintSchema(schema, Config{}), ShouldEqual, `type Mutation {
+  block: Status
+}
+
+enum Status {
+  ACTIVE
+  BLOCKED
+}
+`)
+		})
+	})
+}
+
+func TestNewSchemaNilEntity(t *testing.T) {
+	Convey("Test federated schema with pruned entity", t, func() {
+		query := graphql.NewObject(graphql.ObjectConfig{
+			Name:   "Query",
+			Fields: graphql.Fields{"version": &graphql.Field{Type: graphql.String}},
+		})
+		_, err := NewSchema(graphql.SchemaConfig{Query: query}, Config{
+			Entities: []Entity{{Object: nil, Keys: []string{"id"}}},
+		})
+		So(err, ShouldNotBeNil)
+		So(err.Error(), ShouldContainSubstring, "entity 0 object is nil")
+	})
+}
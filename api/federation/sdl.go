@@ -0,0 +1,281 @@
+package federation
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/graphql-go/graphql"
+)
+
+var builtinTypes = map[string]bool{
+	"String":   true,
+	"Int":      true,
+	"Float":    true,
+	"Boolean":  true,
+	"ID":       true,
+	"_Any":     true,
+	"_Service": true,
+	"_Entity":  true,
+}
+
+// PrintSchema returns SDL of schema with `@key` directives of entities. Federation types and fields are omitted,
+// because gateway adds them itself.
+func PrintSchema(schema graphql.Schema, cfg Config) string {
+	keys := make(map[string][]string)
+	for _, entity := range cfg.Entities {
+		keys[entity.Object.Name()] = entity.Keys
+	}
+
+	typeMap := schema.TypeMap()
+	names := make([]string, 0, len(typeMap))
+	for name := range typeMap {
+		if strings.HasPrefix(name, "__") || builtinTypes[name] {
+			continue
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var blocks []string
+	if def := printSchemaDefinition(schema); def != "" {
+		blocks = append(blocks, def)
+	}
+	for _, name := range names {
+		if block := printType(typeMap[name], keys[name]); block != "" {
+			blocks = append(blocks, block)
+		}
+	}
+
+	return strings.Join(blocks, "\n\n") + "\n"
+}
+
+func printSchemaDefinition(schema graphql.Schema) string {
+	query, mutation := schema.QueryType(), schema.MutationType()
+	if query != nil && len(printedFieldsNames(query.Fields())) == 0 {
+		// query type with federation fields only isn't printed.
+		query = nil
+	}
+	if (query == nil || query.Name() == "Query") && (mutation == nil || mutation.Name() == "Mutation") {
+		return ""
+	}
+	res := "schema {\n"
+	if query != nil {
+		res += "  query: " + query.Name() + "\n"
+	}
+	if mutation != nil {
+		res += "  mutation: " + mutation.Name() + "\n"
+	}
+
+	return res + "}"
+}
+
+func printType(typ graphql.Type, keys []string) string {
+	switch t := typ.(type) {
+	case *graphql.Object:
+		head := "type " + t.Name()
+		if len(t.Interfaces()) > 0 {
+			var interfaces []string
+			for _, iface := range t.Interfaces() {
+				interfaces = append(interfaces, iface.Name())
+			}
+			head += " implements " + strings.Join(interfaces, " & ")
+		}
+		for _, key := range keys {
+			head += " @key(fields: " + printString(key) + ")"
+		}
+		if len(printedFieldsNames(t.Fields())) == 0 {
+			// object with federation fields only (e.g. Query) would be printed without fields, which is invalid SDL.
+			return ""
+		}
+
+		return printDescription(t.Description(), "") + head + printFields(t.Fields())
+	case *graphql.Interface:
+		return printDescription(t.Description(), "") + "interface " + t.Name() + printFields(t.Fields())
+	case *graphql.Union:
+		var types []string
+		for _, obj := range t.Types() {
+			types = append(types, obj.Name())
+		}
+
+		return printDescription(t.Description(), "") + "union " + t.Name() + " = " + strings.Join(types, " | ")
+	case *graphql.Enum:
+		values := make([]*graphql.EnumValueDefinition, len(t.Values()))
+		copy(values, t.Values())
+		sort.Slice(values, func(i, j int) bool {
+			return values[i].Name < values[j].Name
+		})
+		res := printDescription(t.Description(), "") + "enum " + t.Name() + " {\n"
+		for _, value := range values {
+			res += printDescription(value.Description, "  ") + "  " + value.Name + printDeprecated(value.DeprecationReason) + "\n"
+		}
+
+		return res + "}"
+	case *graphql.InputObject:
+		fields := t.Fields()
+		res := printDescription(t.Description(), "") + "input " + t.Name() + " {\n"
+		names := make([]string, 0, len(fields))
+		for name := range fields {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			field := fields[name]
+			res += printDescription(field.Description(), "  ") + "  " + name + ": " + field.Type.String() + printDefault(field.Type, field.DefaultValue) + "\n"
+		}
+
+		return res + "}"
+	case *graphql.Scalar:
+		return printDescription(t.Description(), "") + "scalar " + t.Name()
+	}
+
+	return ""
+}
+
+// printedFieldsNames returns sorted names of fields, which are printed to SDL.
+func printedFieldsNames(fields graphql.FieldDefinitionMap) []string {
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		if name == ServiceFieldName || name == EntitiesFieldName {
+			continue
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+func printFields(fields graphql.FieldDefinitionMap) string {
+	res := " {\n"
+	for _, name := range printedFieldsNames(fields) {
+		field := fields[name]
+		res += printDescription(field.Description, "  ") + "  " + name + printArgs(field.Args) + ": " + field.Type.String() +
+			printDeprecated(field.DeprecationReason) + "\n"
+	}
+
+	return res + "}"
+}
+
+func printArgs(args []*graphql.Argument) string {
+	if len(args) == 0 {
+		return ""
+	}
+	sorted := make([]*graphql.Argument, len(args))
+	copy(sorted, args)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Name() < sorted[j].Name()
+	})
+	var res []string
+	for _, arg := range sorted {
+		res = append(res, arg.Name()+": "+arg.Type.String()+printDefault(arg.Type, arg.DefaultValue))
+	}
+
+	return "(" + strings.Join(res, ", ") + ")"
+}
+
+func printDefault(typ graphql.Type, value interface{}) string {
+	if value == nil {
+		return ""
+	}
+
+	return " = " + printValue(typ, value)
+}
+
+// printValue returns GraphQL literal of input type value.
+func printValue(typ graphql.Type, value interface{}) string {
+	if value == nil {
+		return "null"
+	}
+	switch t := typ.(type) {
+	case *graphql.NonNull:
+		return printValue(t.OfType, value)
+	case *graphql.List:
+		list := reflect.ValueOf(value)
+		if list.Kind() != reflect.Slice && list.Kind() != reflect.Array {
+			// single value is coerced to list.
+			return printValue(t.OfType, value)
+		}
+		values := make([]string, list.Len())
+		for i := range values {
+			values[i] = printValue(t.OfType, list.Index(i).Interface())
+		}
+
+		return "[" + strings.Join(values, ", ") + "]"
+	case *graphql.Enum:
+		if name, ok := t.Serialize(value).(string); ok {
+			return name
+		}
+	case *graphql.InputObject:
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			break
+		}
+		fields := t.Fields()
+		names := make([]string, 0, len(object))
+		for name := range object {
+			if _, ok := fields[name]; ok {
+				names = append(names, name)
+			}
+		}
+		sort.Strings(names)
+		values := make([]string, len(names))
+		for i, name := range names {
+			values[i] = name + ": " + printValue(fields[name].Type, object[name])
+		}
+
+		return "{" + strings.Join(values, ", ") + "}"
+	case *graphql.Scalar:
+		serialized := t.Serialize(value)
+		if str, ok := serialized.(string); ok {
+			return printString(str)
+		}
+		if serialized != nil {
+			return fmt.Sprint(serialized)
+		}
+	}
+
+	return fmt.Sprint(value)
+}
+
+func printDeprecated(reason string) string {
+	if reason == "" {
+		return ""
+	}
+
+	return " @deprecated(reason: " + printString(reason) + ")"
+}
+
+func printDescription(description, indent string) string {
+	if description == "" {
+		return ""
+	}
+	if !strings.Contains(description, "\n") {
+		return indent + printString(description) + "\n"
+	}
+	lines := strings.Split(strings.Replace(description, `"""`, `\"""`, -1), "\n")
+
+	return indent + `"""` + "\n" + indent + strings.Join(lines, "\n"+indent) + "\n" + indent + `"""` + "\n"
+}
+
+func printString(str string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for _, r := range str {
+		switch {
+		case r == '"' || r == '\\':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r == '\n':
+			b.WriteString(`\n`)
+		case r < ' ':
+			b.WriteString(`\u` + fmt.Sprintf("%04x", r))
+		default:
+			b.WriteRune(r)
+		}
+	}
+	b.WriteByte('"')
+
+	return b.String()
+}
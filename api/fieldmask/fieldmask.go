@@ -0,0 +1,105 @@
+package fieldmask
+
+import (
+	"sort"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+// Field describes how GraphQL object field maps to proto message field path.
+// Children is nil for scalar fields and for messages, which are requested as a whole.
+// Requires contains paths of fields, which are needed to resolve field(e.g. data loaders keys).
+type Field struct {
+	Path     string
+	Children func() map[string]Field
+	Requires []string
+}
+
+// Paths returns sorted list of proto field paths, which was requested in resolving field selection set.
+func Paths(info graphql.ResolveInfo, fields map[string]Field) []string {
+	paths := make(map[string]struct{})
+	for _, fieldAST := range info.FieldASTs {
+		collectPaths(info, fieldAST.SelectionSet, "", fields, paths)
+	}
+	res := make([]string, 0, len(paths))
+	for path := range paths {
+		res = append(res, path)
+	}
+	sort.Strings(res)
+
+	return res
+}
+
+func collectPaths(info graphql.ResolveInfo, set *ast.SelectionSet, prefix string, fields map[string]Field, paths map[string]struct{}) bool {
+	if set == nil {
+		return false
+	}
+	var found bool
+	for _, selection := range set.Selections {
+		switch sel := selection.(type) {
+		case *ast.Field:
+			field, ok := fields[sel.Name.Value]
+			if !ok {
+				continue
+			}
+			found = true
+			for _, required := range field.Requires {
+				paths[prefix+required] = struct{}{}
+			}
+			if field.Path == "" {
+				continue
+			}
+			path := prefix + field.Path
+			if field.Children == nil || !collectPaths(info, sel.SelectionSet, path+".", field.Children(), paths) {
+				paths[path] = struct{}{}
+			}
+		case *ast.InlineFragment:
+			if collectPaths(info, sel.SelectionSet, prefix, fields, paths) {
+				found = true
+			}
+		case *ast.FragmentSpread:
+			fragment, ok := info.Fragments[sel.Name.Value].(*ast.FragmentDefinition)
+			if !ok {
+				continue
+			}
+			if collectPaths(info, fragment.SelectionSet, prefix, fields, paths) {
+				found = true
+			}
+		}
+	}
+
+	return found
+}
+
+// ArgsPaths returns sorted list of proto field paths, which was passed in resolving field arguments.
+// Nested input objects are walked recursively, so only passed fields of them are returned.
+func ArgsPaths(args map[string]interface{}, fields map[string]Field) []string {
+	paths := make(map[string]struct{})
+	collectArgsPaths(args, "", fields, paths)
+	res := make([]string, 0, len(paths))
+	for path := range paths {
+		res = append(res, path)
+	}
+	sort.Strings(res)
+
+	return res
+}
+
+func collectArgsPaths(args map[string]interface{}, prefix string, fields map[string]Field, paths map[string]struct{}) bool {
+	var found bool
+	for name, value := range args {
+		field, ok := fields[name]
+		if !ok {
+			continue
+		}
+		found = true
+		path := prefix + field.Path
+		nested, isObject := value.(map[string]interface{})
+		if field.Children == nil || !isObject || !collectArgsPaths(nested, path+".", field.Children(), paths) {
+			paths[path] = struct{}{}
+		}
+	}
+
+	return found
+}
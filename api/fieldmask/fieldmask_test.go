@@ -0,0 +1,93 @@
+package fieldmask
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/parser"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func resolveInfo(query string) graphql.ResolveInfo {
+	doc, err := parser.Parse(parser.ParseParams{Source: query})
+	So(err, ShouldBeNil)
+	info := graphql.ResolveInfo{Fragments: map[string]ast.Definition{}}
+	for _, def := range doc.Definitions {
+		switch d := def.(type) {
+		case *ast.OperationDefinition:
+			info.FieldASTs = []*ast.Field{d.SelectionSet.Selections[0].(*ast.Field)}
+		case *ast.FragmentDefinition:
+			info.Fragments[d.Name.Value] = d
+		}
+	}
+
+	return info
+}
+
+func userFields() map[string]Field {
+	return map[string]Field{
+		"id":      {Path: "id"},
+		"name":    {Path: "name"},
+		"friends": {Path: "friends.users"}, // repeated field is a leaf of mask
+		"manager": {Path: "manager", Children: userFields},
+		"avatar":  {Path: "avatar_id"},
+		"tenant":  {Requires: []string{"tenant_id", "region"}},
+	}
+}
+
+func TestPaths(t *testing.T) {
+	Convey("Test Paths", t, func() {
+		Convey("Should return requested scalar fields", func() {
+			info := resolveInfo(`{ user { id name __typename } }`)
+			So(Paths(info, userFields()), ShouldResemble, []string{"id", "name"})
+		})
+		Convey("Should return nested fields paths", func() {
+			info := resolveInfo(`{ user { id manager { name avatar } } }`)
+			So(Paths(info, userFields()), ShouldResemble, []string{"id", "manager.avatar_id", "manager.name"})
+		})
+		Convey("Should return repeated fields paths without nested fields", func() {
+			info := resolveInfo(`{ user { friends { name avatar } } }`)
+			So(Paths(info, userFields()), ShouldResemble, []string{"friends.users"})
+		})
+		Convey("Should request whole message, if none of its fields is known", func() {
+			info := resolveInfo(`{ user { manager { __typename } } }`)
+			So(Paths(info, userFields()), ShouldResemble, []string{"manager"})
+		})
+		Convey("Should return required fields paths", func() {
+			info := resolveInfo(`{ user { tenant { name } } }`)
+			So(Paths(info, userFields()), ShouldResemble, []string{"region", "tenant_id"})
+		})
+		Convey("Should resolve fragments", func() {
+			info := resolveInfo(`{ user { ...F ... on User { id } } } fragment F on User { name }`)
+			So(Paths(info, userFields()), ShouldResemble, []string{"id", "name"})
+		})
+	})
+}
+
+func TestArgsPaths(t *testing.T) {
+	Convey("Test ArgsPaths", t, func() {
+		Convey("Should return passed fields paths", func() {
+			args := map[string]interface{}{
+				"id":   int64(1),
+				"name": "",
+			}
+			So(ArgsPaths(args, userFields()), ShouldResemble, []string{"id", "name"})
+		})
+		Convey("Should walk nested input objects", func() {
+			args := map[string]interface{}{
+				"manager": map[string]interface{}{
+					"avatar": int64(2),
+				},
+				"unknown": true,
+			}
+			So(ArgsPaths(args, userFields()), ShouldResemble, []string{"manager.avatar_id"})
+		})
+		Convey("Should return whole object path, if it was passed empty", func() {
+			args := map[string]interface{}{
+				"manager": map[string]interface{}{},
+			}
+			So(ArgsPaths(args, userFields()), ShouldResemble, []string{"manager"})
+		})
+	})
+}
@@ -2,6 +2,7 @@ package interceptors
 
 import (
 	"github.com/graphql-go/graphql"
+	"github.com/pkg/errors"
 )
 
 type Context struct {
@@ -18,9 +19,52 @@ type CallMethodInvoker func(req interface{}) (result interface{}, err error)
 type ResolveArgsInterceptor func(ctx *Context, next ResolveArgsInvoker) (result interface{}, err error)
 type CallInterceptor func(ctx *Context, req interface{}, next CallMethodInvoker) (result interface{}, err error)
 
+// Policy is an authorization rule of method or schema field, declared in generator config.
+// It's up to Authorizer, how scopes, roles and named policy are checked.
+type Policy struct {
+	Scopes []string
+	Roles  []string
+	Name   string // named policy
+}
+
+// Authorizer checks, that request is allowed to call method. It's called before arguments are resolved.
+type Authorizer interface {
+	Authorize(c *Context, policy Policy) error
+}
+
+type AuthorizerFunc func(c *Context, policy Policy) error
+
+func (f AuthorizerFunc) Authorize(c *Context, policy Policy) error {
+	return f(c, policy)
+}
+
 type InterceptorHandler struct {
 	ResolveArgsInterceptors []ResolveArgsInterceptor
 	CallInterceptors        []CallInterceptor
+	Authorizer              Authorizer
+}
+
+// Authorize checks policy with handler authorizer. Methods with policy are denied, if authorizer is not set.
+func (d *InterceptorHandler) Authorize(c *Context, policy Policy) error {
+	if d == nil || d.Authorizer == nil {
+		return errors.Errorf("%s.%s requires authorization, but authorizer is not set", c.Service, c.Method)
+	}
+
+	return d.Authorizer.Authorize(c, policy)
+}
+
+// AuthorizeField returns copy of field, which resolver checks policy before resolving.
+func (d *InterceptorHandler) AuthorizeField(service, method string, policy Policy, field *graphql.Field) *graphql.Field {
+	authorized := *field
+	authorized.Resolve = func(p graphql.ResolveParams) (interface{}, error) {
+		if err := d.Authorize(&Context{Service: service, Method: method, Params: p}, policy); err != nil {
+			return nil, err
+		}
+
+		return field.Resolve(p)
+	}
+
+	return &authorized
 }
 
 func (d *InterceptorHandler) ResolveArgs(c *Context, resolve ResolveArgsInterceptor) (res interface{}, err error) {
@@ -0,0 +1,61 @@
+package interceptors
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/pkg/errors"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestAuthorize(t *testing.T) {
+	Convey("Test authorization", t, func() {
+		policy := Policy{Scopes: []string{"users.read"}}
+		ctx := &Context{Service: "UserService", Method: "getUser"}
+
+		Convey("Should deny, if authorizer is not set", func() {
+			var nilHandler *InterceptorHandler
+			So(nilHandler.Authorize(ctx, policy), ShouldNotBeNil)
+			So((&InterceptorHandler{}).Authorize(ctx, policy), ShouldNotBeNil)
+		})
+		Convey("Should pass context and policy to authorizer", func() {
+			var authorizedPolicy Policy
+			ih := &InterceptorHandler{Authorizer: AuthorizerFunc(func(c *Context, p Policy) error {
+				So(c, ShouldEqual, ctx)
+				authorizedPolicy = p
+				return nil
+			})}
+			So(ih.Authorize(ctx, policy), ShouldBeNil)
+			So(authorizedPolicy, ShouldResemble, policy)
+		})
+		Convey("Should check policy before field is resolved", func() {
+			resolved := false
+			field := &graphql.Field{
+				Name: "getUser",
+				Type: graphql.String,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					resolved = true
+					return "user", nil
+				},
+			}
+			denied := errors.New("access denied")
+			ih := &InterceptorHandler{Authorizer: AuthorizerFunc(func(c *Context, p Policy) error {
+				So(c.Service, ShouldEqual, "UserService")
+				So(c.Method, ShouldEqual, "getUser")
+				if len(p.Roles) == 0 {
+					return denied
+				}
+				return nil
+			})}
+
+			_, err := ih.AuthorizeField("UserService", "getUser", policy, field).Resolve(graphql.ResolveParams{})
+			So(err, ShouldEqual, denied)
+			So(resolved, ShouldBeFalse)
+
+			res, err := ih.AuthorizeField("UserService", "getUser", Policy{Roles: []string{"admin"}}, field).Resolve(graphql.ResolveParams{})
+			So(err, ShouldBeNil)
+			So(res, ShouldEqual, "user")
+			So(resolved, ShouldBeTrue)
+		})
+	})
+}
@@ -0,0 +1,226 @@
+// Package relay implements Relay global object identification for generated schemas:
+// `Node` interface, opaque global ids and `node` query field, which refetches objects by global id.
+package relay
+
+import (
+	"encoding/base64"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/graphql-go/graphql"
+	"github.com/pkg/errors"
+)
+
+const (
+	NodeFieldName = "node"
+	IDFieldName   = "id"
+)
+
+var NodeInterface = graphql.NewInterface(graphql.InterfaceConfig{
+	Name:        "Node",
+	Description: "An object with a global ID",
+	Fields: graphql.Fields{
+		IDFieldName: &graphql.Field{
+			Name:        IDFieldName,
+			Description: "The global ID of the object",
+			Type:        graphql.NewNonNull(graphql.ID),
+		},
+	},
+})
+
+// NodeResolver refetches node by key, decoded from global id. Resolver may return `func() (interface{}, error)` thunk
+// to resolve node later.
+type NodeResolver func(p graphql.ResolveParams, key string) (interface{}, error)
+
+// Node is an object, which implements Node interface and could be refetched with `node` query field.
+type Node struct {
+	Object  *graphql.Object
+	Resolve NodeResolver
+}
+
+type Config struct {
+	Nodes []Node
+}
+
+// ToGlobalID returns opaque global id of object of type typ with key.
+func ToGlobalID(typ, key string) string {
+	return base64.StdEncoding.EncodeToString([]byte(typ + ":" + key))
+}
+
+// FromGlobalID returns object type and key, encoded to global id.
+func FromGlobalID(id string) (typ, key string, err error) {
+	decoded, err := base64.StdEncoding.DecodeString(id)
+	if err != nil {
+		return "", "", errors.Wrap(err, "global id must be base64 encoded")
+	}
+	parts := strings.SplitN(string(decoded), ":", 2)
+	if len(parts) != 2 || parts[0] == "" {
+		return "", "", errors.New("global id must contain type name and key")
+	}
+
+	return parts[0], parts[1], nil
+}
+
+// GlobalIDField returns `id` field of node object typ. resolveKey resolves object key, which is encoded to global id.
+func GlobalIDField(typ string, resolveKey graphql.FieldResolveFn) *graphql.Field {
+	return &graphql.Field{
+		Name:        IDFieldName,
+		Description: "The global ID of the object",
+		Type:        graphql.NewNonNull(graphql.ID),
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			key, err := resolveKey(p)
+			if err != nil {
+				return nil, err
+			}
+			value := reflect.ValueOf(key)
+			for value.Kind() == reflect.Ptr && !value.IsNil() {
+				value = value.Elem()
+			}
+			if !value.IsValid() || value.Kind() == reflect.Ptr {
+				return nil, errors.Errorf("%s key is not set", typ)
+			}
+
+			return ToGlobalID(typ, formatKey(value)), nil
+		},
+	}
+}
+
+// AddNodeField adds `node` field to query object of schema config and registers nodes objects in schema types.
+func AddNodeField(schemaCfg *graphql.SchemaConfig, cfg Config) error {
+	if schemaCfg.Query == nil {
+		return errors.New("schema with nodes must have query object")
+	}
+	nodes := make(map[string]Node, len(cfg.Nodes))
+	for _, node := range cfg.Nodes {
+		if _, ok := nodes[node.Object.Name()]; ok {
+			return errors.Errorf("node %s is duplicated", node.Object.Name())
+		}
+		nodes[node.Object.Name()] = node
+		schemaCfg.Types = append(schemaCfg.Types, node.Object)
+	}
+
+	schemaCfg.Query.AddFieldConfig(NodeFieldName, &graphql.Field{
+		Name:        NodeFieldName,
+		Description: "Fetches an object given its global ID",
+		Type:        NodeInterface,
+		Args: graphql.FieldConfigArgument{
+			IDFieldName: &graphql.ArgumentConfig{
+				Type:        graphql.NewNonNull(graphql.ID),
+				Description: "The global ID of the object",
+			},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			id, _ := p.Args[IDFieldName].(string)
+			typ, key, err := FromGlobalID(id)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid global id %s", id)
+			}
+			node, ok := nodes[typ]
+			if !ok {
+				return nil, errors.Errorf("node %s not found", typ)
+			}
+
+			return node.Resolve(p, key)
+		},
+	})
+
+	return nil
+}
+
+// FieldNodeResolver returns resolver, which refetches nodes with query field. Key is passed as argument arg.
+func FieldNodeResolver(field *graphql.Field, arg string) NodeResolver {
+	return func(p graphql.ResolveParams, key string) (interface{}, error) {
+		fieldArg, ok := field.Args[arg]
+		if !ok {
+			return nil, errors.Errorf("field %s argument %s not found", field.Name, arg)
+		}
+		value, err := parseArgument(fieldArg.Type, key)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid key %s", key)
+		}
+		p.Args = map[string]interface{}{arg: value}
+
+		return field.Resolve(p)
+	}
+}
+
+// ParseKey parses key, decoded from global id, to dst. dst must be a pointer to scalar value.
+func ParseKey(key string, dst interface{}) error {
+	value := reflect.ValueOf(dst)
+	if value.Kind() != reflect.Ptr || value.IsNil() {
+		return errors.Errorf("key must be a non-nil pointer, got %T", dst)
+	}
+	value = value.Elem()
+	switch value.Kind() {
+	case reflect.String:
+		value.SetString(key)
+	case reflect.Bool:
+		v, err := strconv.ParseBool(key)
+		if err != nil {
+			return err
+		}
+		value.SetBool(v)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v, err := strconv.ParseInt(key, 10, value.Type().Bits())
+		if err != nil {
+			return err
+		}
+		value.SetInt(v)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		v, err := strconv.ParseUint(key, 10, value.Type().Bits())
+		if err != nil {
+			return err
+		}
+		value.SetUint(v)
+	case reflect.Float32, reflect.Float64:
+		v, err := strconv.ParseFloat(key, value.Type().Bits())
+		if err != nil {
+			return err
+		}
+		value.SetFloat(v)
+	default:
+		return errors.Errorf("key type %s is not supported", value.Type())
+	}
+
+	return nil
+}
+
+func formatKey(key reflect.Value) string {
+	switch key.Kind() {
+	case reflect.String:
+		return key.String()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(key.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(key.Uint(), 10)
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(key.Float(), 'f', -1, key.Type().Bits())
+	case reflect.Bool:
+		return strconv.FormatBool(key.Bool())
+	}
+
+	return fmt.Sprint(key.Interface())
+}
+
+// parseArgument converts key to value of argument type, the same way as graphql-go does for variables.
+func parseArgument(typ graphql.Input, key string) (interface{}, error) {
+	if nonNull, ok := typ.(*graphql.NonNull); ok {
+		typ = nonNull.OfType
+	}
+	var value interface{}
+	switch t := typ.(type) {
+	case *graphql.Scalar:
+		value = t.ParseValue(key)
+	case *graphql.Enum:
+		value = t.ParseValue(key)
+	default:
+		return nil, errors.Errorf("key argument must be scalar or enum, got %s", typ)
+	}
+	if value == nil {
+		return nil, errors.Errorf("key is not a valid %s", typ)
+	}
+
+	return value, nil
+}
@@ -0,0 +1,133 @@
+package relay
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/pkg/errors"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+type user struct {
+	ID   int64
+	Name string
+}
+
+func testSchema() (graphql.Schema, error) {
+	userObject := graphql.NewObject(graphql.ObjectConfig{
+		Name:       "User",
+		Interfaces: []*graphql.Interface{NodeInterface},
+		IsTypeOf: func(p graphql.IsTypeOfParams) bool {
+			_, ok := p.Value.(*user)
+			return ok
+		},
+		Fields: graphql.Fields{
+			"id": GlobalIDField("User", func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(*user).ID, nil
+			}),
+			"name": &graphql.Field{
+				Type: graphql.String,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return p.Source.(*user).Name, nil
+				},
+			},
+		},
+	})
+	getUser := &graphql.Field{
+		Name: "getUser",
+		Type: userObject,
+		Args: graphql.FieldConfigArgument{
+			"user_id": &graphql.ArgumentConfig{Type: graphql.Int},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			id := p.Args["user_id"].(int)
+			if id == 0 {
+				return nil, errors.New("user not found")
+			}
+
+			return &user{ID: int64(id), Name: "user"}, nil
+		},
+	}
+	schemaCfg := graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"getUser": getUser,
+			},
+		}),
+	}
+	err := AddNodeField(&schemaCfg, Config{
+		Nodes: []Node{
+			{
+				Object:  userObject,
+				Resolve: FieldNodeResolver(getUser, "user_id"),
+			},
+		},
+	})
+	if err != nil {
+		return graphql.Schema{}, err
+	}
+
+	return graphql.NewSchema(schemaCfg)
+}
+
+func TestNode(t *testing.T) {
+	Convey("Test node field", t, func() {
+		schema, err := testSchema()
+		So(err, ShouldBeNil)
+
+		query := func(id string) *graphql.Result {
+			return graphql.Do(graphql.Params{
+				Schema:         schema,
+				RequestString:  `query($id: ID!) { node(id: $id) { id ... on User { name } } }`,
+				VariableValues: map[string]interface{}{"id": id},
+			})
+		}
+
+		Convey("Should return global id of object", func() {
+			res := graphql.Do(graphql.Params{
+				Schema:        schema,
+				RequestString: `{ getUser(user_id: 10) { id } }`,
+			})
+			So(res.Errors, ShouldBeEmpty)
+			So(res.Data, ShouldResemble, map[string]interface{}{
+				"getUser": map[string]interface{}{"id": ToGlobalID("User", "10")},
+			})
+		})
+		Convey("Should refetch node by global id", func() {
+			res := query(ToGlobalID("User", "10"))
+			So(res.Errors, ShouldBeEmpty)
+			So(res.Data, ShouldResemble, map[string]interface{}{
+				"node": map[string]interface{}{"id": ToGlobalID("User", "10"), "name": "user"},
+			})
+		})
+		Convey("Should fail on invalid global ids", func() {
+			So(query("not base64").Errors, ShouldHaveLength, 1)
+			So(query(ToGlobalID("Unknown", "10")).Errors, ShouldHaveLength, 1)
+			So(query(ToGlobalID("User", "abc")).Errors, ShouldHaveLength, 1)
+			So(query(ToGlobalID("User", "0")).Errors, ShouldHaveLength, 1)
+		})
+	})
+}
+
+func TestGlobalID(t *testing.T) {
+	Convey("Test global ids", t, func() {
+		typ, key, err := FromGlobalID(ToGlobalID("User", "a:b"))
+		So(err, ShouldBeNil)
+		So(typ, ShouldEqual, "User")
+		So(key, ShouldEqual, "a:b")
+
+		_, _, err = FromGlobalID(ToGlobalID("", "a"))
+		So(err, ShouldNotBeNil)
+
+		var i int32
+		So(ParseKey("11", &i), ShouldBeNil)
+		So(i, ShouldEqual, 11)
+		var u uint64
+		So(ParseKey("-1", &u), ShouldNotBeNil)
+		var s string
+		So(ParseKey("11", &s), ShouldBeNil)
+		So(s, ShouldEqual, "11")
+		So(ParseKey("11", i), ShouldNotBeNil)
+	})
+}
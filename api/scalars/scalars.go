@@ -265,6 +265,44 @@ var GraphQLUInt32Scalar = graphql.NewScalar(graphql.ScalarConfig{
 	},
 })
 
+var GraphQLInt64StringScalar = graphql.NewScalar(graphql.ScalarConfig{
+	Name: "Int64String",
+	Description: "The `Int64String` scalar type represents non-fractional signed whole numeric values. Int can represent values between -(2^63) and 2^63 - 1. \n" +
+		"Is serialized as a string, like in proto3 JSON mapping, so values above 2^53 don't lose precision in JavaScript. Can be passed like a string or a number",
+	Serialize: func(value interface{}) interface{} {
+		if val, ok := GraphQLInt64Scalar.Serialize(value).(int64); ok {
+			return strconv.FormatInt(val, 10)
+		}
+
+		return nil
+	},
+	ParseValue: func(value interface{}) interface{} {
+		return GraphQLInt64Scalar.ParseValue(value)
+	},
+	ParseLiteral: func(valueAST ast.Value) interface{} {
+		return GraphQLInt64Scalar.ParseLiteral(valueAST)
+	},
+})
+
+var GraphQLUInt64StringScalar = graphql.NewScalar(graphql.ScalarConfig{
+	Name: "UInt64String",
+	Description: "The `UInt64String` scalar type represents non-fractional unsigned whole numeric values. Int can represent values between 0 and 2^64 - 1.\n" +
+		"Is serialized as a string, like in proto3 JSON mapping, so values above 2^53 don't lose precision in JavaScript. Can be passed like a string or a number",
+	Serialize: func(value interface{}) interface{} {
+		if val, ok := GraphQLUInt64Scalar.Serialize(value).(uint64); ok {
+			return strconv.FormatUint(val, 10)
+		}
+
+		return nil
+	},
+	ParseValue: func(value interface{}) interface{} {
+		return GraphQLUInt64Scalar.ParseValue(value)
+	},
+	ParseLiteral: func(valueAST ast.Value) interface{} {
+		return GraphQLUInt64Scalar.ParseLiteral(valueAST)
+	},
+})
+
 var GraphQLFloat32Scalar = graphql.NewScalar(graphql.ScalarConfig{
 	Name: "Float32",
 	Serialize: func(value interface{}) interface{} {
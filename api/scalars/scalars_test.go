@@ -103,6 +103,46 @@ func TestGraphQLUInt32Scalar(t *testing.T) {
 	})
 }
 
+func TestGraphQLInt64StringScalar(t *testing.T) {
+	Convey("Test GraphQLInt64StringScalar.Serialize", t, func() {
+		So(GraphQLInt64StringScalar.Serialize(int64(9007199254740993)), ShouldEqual, "9007199254740993")
+		So(GraphQLInt64StringScalar.Serialize(int64(-534)), ShouldEqual, "-534")
+		So(GraphQLInt64StringScalar.Serialize(int32(534)), ShouldEqual, "534")
+		So(GraphQLInt64StringScalar.Serialize((*int64)(nil)), ShouldEqual, nil)
+		So(GraphQLInt64StringScalar.Serialize("123"), ShouldEqual, nil)
+	})
+	Convey("Test GraphQLInt64StringScalar.ParseValue", t, func() {
+		So(GraphQLInt64StringScalar.ParseValue("9007199254740993"), ShouldEqual, int64(9007199254740993))
+		So(GraphQLInt64StringScalar.ParseValue(float64(534)), ShouldEqual, int64(534))
+		So(GraphQLInt64StringScalar.ParseValue("s123"), ShouldEqual, nil)
+	})
+	Convey("Test GraphQLInt64StringScalar.ParseLiteral", t, func() {
+		So(GraphQLInt64StringScalar.ParseLiteral(&ast.IntValue{Kind: kinds.IntValue, Value: "9007199254740993"}), ShouldEqual, int64(9007199254740993))
+		So(GraphQLInt64StringScalar.ParseLiteral(&ast.StringValue{Kind: kinds.StringValue, Value: "-534"}), ShouldEqual, int64(-534))
+		So(GraphQLInt64StringScalar.ParseLiteral(&ast.StringValue{Kind: kinds.StringValue, Value: "s534"}), ShouldEqual, nil)
+		So(GraphQLInt64StringScalar.ParseLiteral(&ast.StringValue{Kind: kinds.BooleanValue, Value: "true"}), ShouldEqual, nil)
+	})
+}
+
+func TestGraphQLUInt64StringScalar(t *testing.T) {
+	Convey("Test GraphQLUInt64StringScalar.Serialize", t, func() {
+		So(GraphQLUInt64StringScalar.Serialize(uint64(18446744073709551615)), ShouldEqual, "18446744073709551615")
+		So(GraphQLUInt64StringScalar.Serialize(uint32(534)), ShouldEqual, "534")
+		So(GraphQLUInt64StringScalar.Serialize((*uint64)(nil)), ShouldEqual, nil)
+		So(GraphQLUInt64StringScalar.Serialize(int64(534)), ShouldEqual, nil)
+	})
+	Convey("Test GraphQLUInt64StringScalar.ParseValue", t, func() {
+		So(GraphQLUInt64StringScalar.ParseValue("18446744073709551615"), ShouldEqual, uint64(18446744073709551615))
+		So(GraphQLUInt64StringScalar.ParseValue(float64(534)), ShouldEqual, uint64(534))
+		So(GraphQLUInt64StringScalar.ParseValue("-1"), ShouldEqual, nil)
+	})
+	Convey("Test GraphQLUInt64StringScalar.ParseLiteral", t, func() {
+		So(GraphQLUInt64StringScalar.ParseLiteral(&ast.IntValue{Kind: kinds.IntValue, Value: "18446744073709551615"}), ShouldEqual, uint64(18446744073709551615))
+		So(GraphQLUInt64StringScalar.ParseLiteral(&ast.StringValue{Kind: kinds.StringValue, Value: "534"}), ShouldEqual, uint64(534))
+		So(GraphQLUInt64StringScalar.ParseLiteral(&ast.StringValue{Kind: kinds.StringValue, Value: "s534"}), ShouldEqual, nil)
+	})
+}
+
 func TestGraphQLFloat32Scalar(t *testing.T) {
 	Convey("Test GraphQLFloat32Scalar.Serialize", t, func() {
 		So(GraphQLFloat32Scalar.Serialize(float32(534)), ShouldEqual, float32(534))
@@ -0,0 +1,397 @@
+// Package visibility prunes hidden types, fields, arguments and enum values from schema config,
+// so the same generated types could be served by several schemas with different visibility.
+package visibility
+
+import (
+	"github.com/graphql-go/graphql"
+	"github.com/pkg/errors"
+)
+
+// Hidden lists schema elements, which must not be visible in schema.
+type Hidden struct {
+	Types      []string // types names
+	Fields     []string // `Type.field` keys of objects, interfaces and input objects fields
+	Arguments  []string // `Type.field.argument` keys
+	EnumValues []string // `Enum.VALUE` keys
+}
+
+// Pruner copies schema types without hidden elements. Types, which have no visible fields (values, union members) left,
+// are hidden too, as well as fields of hidden types and fields with required arguments of hidden types.
+// Types, which are not reachable from schema roots, are not copied.
+type Pruner struct {
+	hiddenTypes      map[string]bool
+	hiddenFields     map[string]bool
+	hiddenArguments  map[string]bool
+	hiddenEnumValues map[string]bool
+
+	types  map[string]graphql.Type // original types, reachable from pruned roots
+	copies map[string]graphql.Type
+}
+
+func NewPruner(hidden Hidden) *Pruner {
+	return &Pruner{
+		hiddenTypes:      stringsSet(hidden.Types),
+		hiddenFields:     stringsSet(hidden.Fields),
+		hiddenArguments:  stringsSet(hidden.Arguments),
+		hiddenEnumValues: stringsSet(hidden.EnumValues),
+		types:            make(map[string]graphql.Type),
+		copies:           make(map[string]graphql.Type),
+	}
+}
+
+// Prune replaces schema config roots and types with pruned copies.
+func (p *Pruner) Prune(schemaCfg *graphql.SchemaConfig) error {
+	roots := []graphql.Type{schemaCfg.Query}
+	if schemaCfg.Mutation != nil {
+		roots = append(roots, schemaCfg.Mutation)
+	}
+	if schemaCfg.Subscription != nil {
+		roots = append(roots, schemaCfg.Subscription)
+	}
+	for _, typ := range schemaCfg.Types {
+		roots = append(roots, typ)
+	}
+	p.add(roots...)
+
+	if schemaCfg.Query == nil || p.Object(schemaCfg.Query) == nil {
+		return errors.New("schema query object has no visible fields")
+	}
+	schemaCfg.Query = p.Object(schemaCfg.Query)
+	if schemaCfg.Mutation != nil {
+		schemaCfg.Mutation = p.Object(schemaCfg.Mutation)
+	}
+	if schemaCfg.Subscription != nil {
+		schemaCfg.Subscription = p.Object(schemaCfg.Subscription)
+	}
+	var types []graphql.Type
+	for _, typ := range schemaCfg.Types {
+		if typ := p.copies[typ.Name()]; typ != nil {
+			types = append(types, typ)
+		}
+	}
+	schemaCfg.Types = types
+
+	return nil
+}
+
+// Object returns pruned copy of object or nil, if object is hidden.
+func (p *Pruner) Object(object *graphql.Object) *graphql.Object {
+	if object == nil {
+		return nil
+	}
+	p.add(object)
+	res, _ := p.copies[object.Name()].(*graphql.Object)
+
+	return res
+}
+
+// add copies roots and all types, reachable from them, which were not copied yet.
+func (p *Pruner) add(roots ...graphql.Type) {
+	var added []graphql.Type
+	for _, root := range roots {
+		if root == nil {
+			continue
+		}
+		added = p.collect(root, added)
+	}
+	p.hideEmptyTypes()
+
+	var copied []graphql.Type
+	for _, typ := range added {
+		if p.hiddenTypes[typ.Name()] {
+			continue
+		}
+		p.copies[typ.Name()] = p.copyShell(typ)
+		copied = append(copied, typ)
+	}
+	for _, typ := range copied {
+		p.fillCopy(typ)
+	}
+}
+
+func (p *Pruner) collect(typ graphql.Type, added []graphql.Type) []graphql.Type {
+	typ = namedType(typ)
+	if _, ok := p.types[typ.Name()]; ok {
+		return added
+	}
+	p.types[typ.Name()] = typ
+	added = append(added, typ)
+	switch t := typ.(type) {
+	case *graphql.Object:
+		for _, iface := range t.Interfaces() {
+			added = p.collect(iface, added)
+		}
+		added = p.collectFields(t.Fields(), added)
+	case *graphql.Interface:
+		added = p.collectFields(t.Fields(), added)
+	case *graphql.Union:
+		for _, object := range t.Types() {
+			added = p.collect(object, added)
+		}
+	case *graphql.InputObject:
+		for _, field := range t.Fields() {
+			added = p.collect(field.Type, added)
+		}
+	}
+
+	return added
+}
+
+func (p *Pruner) collectFields(fields graphql.FieldDefinitionMap, added []graphql.Type) []graphql.Type {
+	for _, field := range fields {
+		added = p.collect(field.Type, added)
+		for _, arg := range field.Args {
+			added = p.collect(arg.Type, added)
+		}
+	}
+
+	return added
+}
+
+// hideEmptyTypes hides types without visible fields until no more types become empty.
+func (p *Pruner) hideEmptyTypes() {
+	for changed := true; changed; {
+		changed = false
+		for name, typ := range p.types {
+			if !p.hiddenTypes[name] && p.empty(typ) {
+				p.hiddenTypes[name] = true
+				changed = true
+			}
+		}
+	}
+}
+
+func (p *Pruner) empty(typ graphql.Type) bool {
+	switch t := typ.(type) {
+	case *graphql.Object:
+		for _, field := range t.Fields() {
+			if p.fieldVisible(t.Name(), field) {
+				return false
+			}
+		}
+	case *graphql.Interface:
+		for _, field := range t.Fields() {
+			if p.fieldVisible(t.Name(), field) {
+				return false
+			}
+		}
+	case *graphql.Union:
+		for _, object := range t.Types() {
+			if !p.hiddenTypes[object.Name()] {
+				return false
+			}
+		}
+	case *graphql.InputObject:
+		for _, field := range t.Fields() {
+			if p.inputFieldVisible(t.Name(), field) {
+				return false
+			}
+		}
+	case *graphql.Enum:
+		for _, value := range t.Values() {
+			if !p.hiddenEnumValues[t.Name()+"."+value.Name] {
+				return false
+			}
+		}
+	default:
+		return false
+	}
+
+	return true
+}
+
+func (p *Pruner) fieldVisible(typ string, field *graphql.FieldDefinition) bool {
+	if p.hiddenFields[typ+"."+field.Name] || p.hiddenTypes[namedType(field.Type).Name()] {
+		return false
+	}
+	for _, arg := range field.Args {
+		if _, required := arg.Type.(*graphql.NonNull); required && !p.argumentVisible(typ, field.Name, arg) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (p *Pruner) argumentVisible(typ, field string, arg *graphql.Argument) bool {
+	return !p.hiddenArguments[typ+"."+field+"."+arg.Name()] && !p.hiddenTypes[namedType(arg.Type).Name()]
+}
+
+func (p *Pruner) inputFieldVisible(typ string, field *graphql.InputObjectField) bool {
+	return !p.hiddenFields[typ+"."+field.Name()] && !p.hiddenTypes[namedType(field.Type).Name()]
+}
+
+// copyShell returns copy of type without fields, so types could reference each other before fields are copied.
+func (p *Pruner) copyShell(typ graphql.Type) graphql.Type {
+	switch t := typ.(type) {
+	case *graphql.Object:
+		return graphql.NewObject(graphql.ObjectConfig{
+			Name:        t.Name(),
+			Description: t.Description(),
+			IsTypeOf:    t.IsTypeOf,
+			Interfaces: graphql.InterfacesThunk(func() []*graphql.Interface {
+				var res []*graphql.Interface
+				for _, iface := range t.Interfaces() {
+					if copied, ok := p.copies[iface.Name()].(*graphql.Interface); ok {
+						res = append(res, copied)
+					}
+				}
+
+				return res
+			}),
+			Fields: graphql.Fields{},
+		})
+	case *graphql.Interface:
+		return graphql.NewInterface(graphql.InterfaceConfig{
+			Name:        t.Name(),
+			Description: t.Description(),
+			ResolveType: p.resolveType(t.ResolveType),
+			Fields:      graphql.Fields{},
+		})
+	case *graphql.Union:
+		return graphql.NewUnion(graphql.UnionConfig{
+			Name:        t.Name(),
+			Description: t.Description(),
+			ResolveType: p.resolveType(t.ResolveType),
+			Types: graphql.UnionTypesThunk(func() []*graphql.Object {
+				var res []*graphql.Object
+				for _, object := range t.Types() {
+					if copied, ok := p.copies[object.Name()].(*graphql.Object); ok {
+						res = append(res, copied)
+					}
+				}
+
+				return res
+			}),
+		})
+	case *graphql.InputObject:
+		return graphql.NewInputObject(graphql.InputObjectConfig{
+			Name:        t.Name(),
+			Description: t.Description(),
+			Fields: graphql.InputObjectConfigFieldMapThunk(func() graphql.InputObjectConfigFieldMap {
+				res := graphql.InputObjectConfigFieldMap{}
+				for name, field := range t.Fields() {
+					if !p.inputFieldVisible(t.Name(), field) {
+						continue
+					}
+					res[name] = &graphql.InputObjectFieldConfig{
+						Type:         p.copyType(field.Type).(graphql.Input),
+						DefaultValue: field.DefaultValue,
+						Description:  field.Description(),
+					}
+				}
+
+				return res
+			}),
+		})
+	case *graphql.Enum:
+		values := graphql.EnumValueConfigMap{}
+		for _, value := range t.Values() {
+			if p.hiddenEnumValues[t.Name()+"."+value.Name] {
+				continue
+			}
+			values[value.Name] = &graphql.EnumValueConfig{
+				Value:             value.Value,
+				DeprecationReason: value.DeprecationReason,
+				Description:       value.Description,
+			}
+		}
+
+		return graphql.NewEnum(graphql.EnumConfig{
+			Name:        t.Name(),
+			Description: t.Description(),
+			Values:      values,
+		})
+	}
+
+	return typ
+}
+
+// fillCopy copies visible fields of object or interface to it's copy.
+func (p *Pruner) fillCopy(typ graphql.Type) {
+	switch t := typ.(type) {
+	case *graphql.Object:
+		p.copyFields(t.Name(), t.Fields(), p.copies[t.Name()].(*graphql.Object).AddFieldConfig)
+	case *graphql.Interface:
+		p.copyFields(t.Name(), t.Fields(), p.copies[t.Name()].(*graphql.Interface).AddFieldConfig)
+	}
+}
+
+func (p *Pruner) copyFields(typ string, fields graphql.FieldDefinitionMap, addField func(name string, field *graphql.Field)) {
+	for name, field := range fields {
+		if !p.fieldVisible(typ, field) {
+			continue
+		}
+		args := graphql.FieldConfigArgument{}
+		for _, arg := range field.Args {
+			if !p.argumentVisible(typ, name, arg) {
+				continue
+			}
+			args[arg.Name()] = &graphql.ArgumentConfig{
+				Type:         p.copyType(arg.Type).(graphql.Input),
+				DefaultValue: arg.DefaultValue,
+				Description:  arg.Description(),
+			}
+		}
+		addField(name, &graphql.Field{
+			Name:              field.Name,
+			Type:              p.copyType(field.Type).(graphql.Output),
+			Args:              args,
+			Resolve:           field.Resolve,
+			Subscribe:         field.Subscribe,
+			DeprecationReason: field.DeprecationReason,
+			Description:       field.Description,
+		})
+	}
+}
+
+// copyType replaces named type of typ with it's copy.
+func (p *Pruner) copyType(typ graphql.Type) graphql.Type {
+	switch t := typ.(type) {
+	case *graphql.NonNull:
+		return graphql.NewNonNull(p.copyType(t.OfType))
+	case *graphql.List:
+		return graphql.NewList(p.copyType(t.OfType))
+	}
+
+	return p.copies[typ.Name()]
+}
+
+// resolveType returns copy of object, resolved by abstract type resolver.
+func (p *Pruner) resolveType(resolve graphql.ResolveTypeFn) graphql.ResolveTypeFn {
+	if resolve == nil {
+		return nil
+	}
+
+	return func(params graphql.ResolveTypeParams) *graphql.Object {
+		object := resolve(params)
+		if object == nil {
+			return nil
+		}
+		copied, _ := p.copies[object.Name()].(*graphql.Object)
+
+		return copied
+	}
+}
+
+func namedType(typ graphql.Type) graphql.Type {
+	for {
+		switch t := typ.(type) {
+		case *graphql.NonNull:
+			typ = t.OfType
+		case *graphql.List:
+			typ = t.OfType
+		default:
+			return typ
+		}
+	}
+}
+
+func stringsSet(values []string) map[string]bool {
+	res := make(map[string]bool, len(values))
+	for _, value := range values {
+		res[value] = true
+	}
+
+	return res
+}
@@ -0,0 +1,133 @@
+package visibility
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+type user struct {
+	Name   string
+	Notes  string
+	Status int
+}
+
+func testSchemaConfig() graphql.SchemaConfig {
+	status := graphql.NewEnum(graphql.EnumConfig{
+		Name: "Status",
+		Values: graphql.EnumValueConfigMap{
+			"ACTIVE":  &graphql.EnumValueConfig{Value: 1},
+			"DELETED": &graphql.EnumValueConfig{Value: 2},
+		},
+	})
+	audit := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Audit",
+		Fields: graphql.Fields{
+			"log": &graphql.Field{Type: graphql.String},
+		},
+	})
+	userObject := graphql.NewObject(graphql.ObjectConfig{
+		Name: "User",
+		Fields: graphql.Fields{
+			"name":   &graphql.Field{Type: graphql.String},
+			"notes":  &graphql.Field{Type: graphql.String},
+			"status": &graphql.Field{Type: status},
+			"audit":  &graphql.Field{Type: audit},
+		},
+	})
+	userObject.AddFieldConfig("friends", &graphql.Field{Type: graphql.NewList(userObject)})
+	filter := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "Filter",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"status":     &graphql.InputObjectFieldConfig{Type: status},
+			"with_notes": &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+		},
+	})
+
+	return graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"users": &graphql.Field{
+					Type: graphql.NewList(userObject),
+					Args: graphql.FieldConfigArgument{
+						"filter": &graphql.ArgumentConfig{Type: filter},
+						"debug":  &graphql.ArgumentConfig{Type: graphql.Boolean},
+					},
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return []user{{Name: "user", Notes: "notes", Status: 2}}, nil
+					},
+				},
+				"audit": &graphql.Field{
+					Type: audit,
+					Args: graphql.FieldConfigArgument{
+						"status": &graphql.ArgumentConfig{Type: graphql.NewNonNull(status)},
+					},
+				},
+			},
+		}),
+	}
+}
+
+func TestPrune(t *testing.T) {
+	Convey("Test schema pruning", t, func() {
+		schemaCfg := testSchemaConfig()
+		query := schemaCfg.Query
+		pruner := NewPruner(Hidden{
+			Types:      []string{"Audit"},
+			Fields:     []string{"User.notes", "Filter.with_notes"},
+			Arguments:  []string{"Query.users.debug"},
+			EnumValues: []string{"Status.DELETED"},
+		})
+		So(pruner.Prune(&schemaCfg), ShouldBeNil)
+		schema, err := graphql.NewSchema(schemaCfg)
+		So(err, ShouldBeNil)
+
+		Convey("Hidden elements should be removed", func() {
+			So(schema.Type("Audit"), ShouldBeNil)
+			So(schema.QueryType().Fields(), ShouldNotContainKey, "audit")
+
+			userObject := schema.Type("User").(*graphql.Object)
+			So(userObject.Fields(), ShouldNotContainKey, "notes")
+			So(userObject.Fields(), ShouldNotContainKey, "audit")
+			So(userObject.Fields(), ShouldContainKey, "friends")
+			So(userObject.Fields()["friends"].Type.(*graphql.List).OfType, ShouldEqual, userObject)
+
+			So(schema.Type("Filter").(*graphql.InputObject).Fields(), ShouldNotContainKey, "with_notes")
+			So(schema.Type("Status").(*graphql.Enum).Values(), ShouldHaveLength, 1)
+			So(schema.QueryType().Fields()["users"].Args, ShouldHaveLength, 1)
+		})
+		Convey("Original types should not be changed", func() {
+			So(query.Fields(), ShouldContainKey, "audit")
+			So(query.Fields()["users"].Args, ShouldHaveLength, 2)
+		})
+		Convey("Pruned schema should resolve visible fields", func() {
+			res := graphql.Do(graphql.Params{
+				Schema:        schema,
+				RequestString: `{ users(filter: {status: ACTIVE}) { name } }`,
+			})
+			So(res.Errors, ShouldBeEmpty)
+			So(res.Data, ShouldResemble, map[string]interface{}{
+				"users": []interface{}{map[string]interface{}{"name": "user"}},
+			})
+
+			res = graphql.Do(graphql.Params{
+				Schema:        schema,
+				RequestString: `{ users { notes } }`,
+			})
+			So(res.Errors, ShouldHaveLength, 1)
+		})
+	})
+	Convey("Test hiding of types without visible fields", t, func() {
+		schemaCfg := testSchemaConfig()
+		pruner := NewPruner(Hidden{
+			Fields: []string{"Query.users", "Audit.log"},
+		})
+		So(pruner.Prune(&schemaCfg), ShouldNotBeNil)
+
+		userObject := pruner.Object(schemaCfg.Query.Fields()["users"].Type.(*graphql.List).OfType.(*graphql.Object))
+		So(userObject, ShouldNotBeNil)
+		So(userObject.Fields(), ShouldNotContainKey, "audit")
+	})
+}
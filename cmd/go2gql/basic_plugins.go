@@ -7,6 +7,7 @@ import (
 
 	"github.com/EGT-Ukraine/go2gql/generator"
 	"github.com/EGT-Ukraine/go2gql/generator/plugins/dataloader"
+	"github.com/EGT-Ukraine/go2gql/generator/plugins/external"
 	"github.com/EGT-Ukraine/go2gql/generator/plugins/graphql"
 	"github.com/EGT-Ukraine/go2gql/generator/plugins/proto2gql"
 	"github.com/EGT-Ukraine/go2gql/generator/plugins/swagger2gql"
@@ -18,5 +19,6 @@ func Plugins(c *cli.Context) []generator.Plugin {
 		new(graphql.Plugin),
 		new(swagger2gql.Plugin),
 		new(proto2gql.Plugin),
+		new(external.Plugin),
 	}
 }
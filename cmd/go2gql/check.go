@@ -0,0 +1,86 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/pmezard/go-difflib/difflib"
+	"github.com/urfave/cli"
+
+	"github.com/EGT-Ukraine/go2gql/generator"
+)
+
+var checkCommand = cli.Command{
+	Name:  "check",
+	Usage: "Check, that generated files are up to date. Prints unified diffs of files, which generation would change",
+	Action: func(c *cli.Context) error {
+		g, err := newGenerator(c.Parent())
+		if err != nil {
+			return err
+		}
+		changes, err := g.Check()
+		if err != nil {
+			return errors.Wrap(err, "failed to generate")
+		}
+		if len(changes) == 0 {
+			return nil
+		}
+
+		var summary []string
+		for _, change := range changes {
+			diff, err := unifiedDiff(change)
+			if err != nil {
+				return errors.Wrapf(err, "failed to diff file %s", change.Path)
+			}
+			fmt.Print(diff)
+
+			action := "modified"
+			if change.Created {
+				action = "created"
+			} else if change.Removed {
+				action = "removed"
+			}
+			summary = append(summary, action+": "+displayPath(change.Path))
+		}
+
+		return errors.Errorf("generated files are out of date:\n\t%s", strings.Join(summary, "\n\t"))
+	},
+}
+
+func unifiedDiff(change generator.FileChange) (string, error) {
+	path := filepath.ToSlash(displayPath(change.Path))
+	diff := difflib.UnifiedDiff{
+		A:        difflib.SplitLines(string(change.Old)),
+		B:        difflib.SplitLines(string(change.New)),
+		FromFile: "a/" + path,
+		ToFile:   "b/" + path,
+		Context:  3,
+	}
+	if change.Created {
+		diff.A = nil
+		diff.FromFile = "/dev/null"
+	}
+	if change.Removed {
+		diff.B = nil
+		diff.ToFile = "/dev/null"
+	}
+
+	return difflib.GetUnifiedDiffString(diff)
+}
+
+// displayPath returns path relative to working directory, if it's possible.
+func displayPath(path string) string {
+	wd, err := os.Getwd()
+	if err != nil {
+		return path
+	}
+	rel, err := filepath.Rel(wd, path)
+	if err != nil || strings.HasPrefix(rel, "..") {
+		return path
+	}
+
+	return rel
+}
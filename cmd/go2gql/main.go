@@ -20,49 +20,55 @@ var appFlags = []cli.Flag{
 	},
 }
 
-func main() {
-	app := cli.App{
-		Flags: appFlags,
-		Before: func(c *cli.Context) error {
-			cfg, err := ioutil.ReadFile(c.String("config"))
-			if err != nil {
-				return errors.Wrap(err, "Failed to read config file")
-			}
-			gc := new(generator.GenerateConfig)
-			if err = yaml.Unmarshal(cfg, gc); err != nil {
-				return errors.Wrap(err, "Failed to unmarshal config file")
-			}
+// newGenerator reads config, registers plugins, initializes and prepares them. c must be application context.
+// Generator is returned even on error, so it's config and plugins sources can be watched.
+func newGenerator(c *cli.Context) (*generator.Generator, error) {
+	g := &generator.Generator{
+		Config: &generator.GenerateConfig{
+			Path: c.String("config"),
+		},
+	}
+	cfg, err := ioutil.ReadFile(c.String("config"))
+	if err != nil {
+		return g, errors.Wrap(err, "Failed to read config file")
+	}
+	g.Config.Source = cfg
+	if err = yaml.Unmarshal(cfg, g.Config); err != nil {
+		return g, errors.Wrap(err, "Failed to unmarshal config file")
+	}
 
-			if err = gc.ParseImports(); err != nil {
-				return errors.Wrap(err, "Failed to parse config file imports")
-			}
+	if err = g.Config.ParseImports(); err != nil {
+		return g, errors.Wrap(err, "Failed to parse config file imports")
+	}
 
-			g := &generator.Generator{
-				Config: gc,
-			}
+	for _, plugin := range Plugins(c) {
+		if err := g.RegisterPlugin(plugin); err != nil {
+			return g, errors.Wrap(err, "Failed to register plugin")
+		}
+	}
+	if err = g.Init(); err != nil {
+		return g, errors.Wrap(err, "failed to initialize generator")
+	}
+	if err = g.Prepare(); err != nil {
+		return g, errors.Wrap(err, "failed to prepare generator")
+	}
 
-			for _, plugin := range Plugins(c) {
-				if err := g.RegisterPlugin(plugin); err != nil {
-					return errors.Wrap(err, "Failed to register plugin")
-				}
-			}
-			if err = g.Init(); err != nil {
-				return errors.Wrap(err, "failed to initialize generator")
-			}
-			if err = g.Prepare(); err != nil {
-				return errors.Wrap(err, "failed to prepare generator")
-			}
-			c.App.Metadata["generator"] = g
+	return g, nil
+}
 
-			return nil
-		},
+func main() {
+	app := cli.App{
+		Flags: appFlags,
 		Commands: []cli.Command{
 			{
 				Name:    "info-keys",
 				Aliases: []string{"ik"},
 				Usage:   "Print all possible info keys",
-				Action: func(c *cli.Context) {
-					g := c.App.Metadata["generator"].(*generator.Generator)
+				Action: func(c *cli.Context) error {
+					g, err := newGenerator(c.Parent())
+					if err != nil {
+						return err
+					}
 					for plugin, keys := range g.GetPluginsInfosKeys() {
 						if len(keys) > 0 {
 							fmt.Println(plugin)
@@ -71,6 +77,8 @@ func main() {
 							}
 						}
 					}
+
+					return nil
 				},
 			},
 			{
@@ -82,18 +90,26 @@ func main() {
 						Name: "infos",
 					},
 				},
-				Action: func(c *cli.Context) {
-					g := c.App.Metadata["generator"].(*generator.Generator)
+				Action: func(c *cli.Context) error {
+					g, err := newGenerator(c.Parent())
+					if err != nil {
+						return err
+					}
 					g.PrintInfos(c.StringSlice("infos"))
+
+					return nil
 				},
 			},
+			checkCommand,
+			watchCommand,
 		},
-		Action: func(c *cli.Context) {
-			g := c.App.Metadata["generator"].(*generator.Generator)
-			err := g.Generate()
+		Action: func(c *cli.Context) error {
+			g, err := newGenerator(c)
 			if err != nil {
-				panic(errors.Wrap(err, "failed to generate"))
+				return err
 			}
+
+			return errors.Wrap(g.Generate(), "failed to generate")
 		},
 	}
 
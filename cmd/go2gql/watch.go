@@ -0,0 +1,123 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+)
+
+var watchCommand = cli.Command{
+	Name:    "watch",
+	Aliases: []string{"w"},
+	Usage:   "Regenerate on config, it's imports, proto and swagger files changes",
+	Flags: []cli.Flag{
+		cli.DurationFlag{
+			Name:  "interval",
+			Value: 500 * time.Millisecond,
+			Usage: "Files changes polling interval",
+		},
+	},
+	Action: func(c *cli.Context) error {
+		watch(c.Parent(), c.Duration("interval"))
+
+		return nil
+	},
+}
+
+type fileState struct {
+	exists  bool
+	modTime time.Time
+	size    int64
+}
+
+func sourcesState(sources map[string]struct{}) map[string]fileState {
+	res := make(map[string]fileState, len(sources))
+	for source := range sources {
+		res[source] = sourceState(source)
+	}
+
+	return res
+}
+
+func sourceState(source string) fileState {
+	info, err := os.Stat(source)
+	if err != nil {
+		return fileState{}
+	}
+
+	return fileState{
+		exists:  true,
+		modTime: info.ModTime(),
+		size:    info.Size(),
+	}
+}
+
+// generate runs all generation phases. Plugins panics are returned as errors, so watch keeps working.
+func generate(c *cli.Context) (sources []string, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = errors.Errorf("panic: %v", r)
+		}
+	}()
+
+	g, err := newGenerator(c)
+	if g != nil {
+		defer func() {
+			sources = g.Sources()
+		}()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, errors.Wrap(g.Generate(), "failed to generate")
+}
+
+// watch generates files and polls watched sources for changes. Set of sources is never shrunk, so files,
+// which were not reached due to error, are still watched.
+func watch(c *cli.Context, interval time.Duration) {
+	sources := make(map[string]struct{})
+	if configPath, err := filepath.Abs(c.String("config")); err == nil {
+		sources[configPath] = struct{}{}
+	}
+
+	for {
+		state := sourcesState(sources)
+		start := time.Now()
+
+		newSources, err := generate(c)
+		for _, source := range newSources {
+			if _, ok := sources[source]; !ok {
+				sources[source] = struct{}{}
+				state[source] = sourceState(source)
+			}
+		}
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%s generation failed: %s\n", time.Now().Format("15:04:05"), err)
+		} else {
+			fmt.Printf("%s generated in %s\n", time.Now().Format("15:04:05"), time.Since(start).Round(time.Millisecond))
+		}
+
+		changed := waitForChange(sources, state, interval)
+		fmt.Printf("%s changed\n", changed)
+	}
+}
+
+// waitForChange blocks until one of sources changes and returns it's path. Generation starts after
+// one more interval, so editors are able to finish writing files.
+func waitForChange(sources map[string]struct{}, state map[string]fileState, interval time.Duration) string {
+	for {
+		time.Sleep(interval)
+		for source := range sources {
+			if sourceState(source) != state[source] {
+				time.Sleep(interval)
+
+				return source
+			}
+		}
+	}
+}
@@ -12,6 +12,7 @@ import (
 type ImportedPluginsConfigs struct {
 	Path           string
 	PluginsConfigs PluginsConfigs
+	Source         []byte // yaml content of imported file. Used to report config errors lines
 }
 
 type PluginsConfigs map[string]interface{}
@@ -19,8 +20,26 @@ type GenerateConfig struct {
 	GenerateTraces        bool     `yaml:"generate_tracer"`
 	VendorPath            string   `yaml:"vendor_path"`
 	Imports               []string `yaml:"imports"`
+	TemplatesDir          string   `yaml:"templates_dir"`
+	TemplatesVersion      int      `yaml:"templates_version"`
 	PluginsConfigsImports []ImportedPluginsConfigs
 	PluginsConfigs        `yaml:",inline"`
+
+	Path   string  `yaml:"-"` // config file path
+	Source []byte  `yaml:"-"` // yaml content of config file. Used to report config errors lines
+	Output *Output `yaml:"-"` // plugins should write generated files to output. Set by generator before generation
+}
+
+// DecodePluginConfig strictly decodes plugin config by key to output.
+// Unknown keys are reported with config file lines.
+func (gc *GenerateConfig) DecodePluginConfig(key string, output interface{}) error {
+	return decodePluginConfig(gc.Path, gc.Source, key, gc.PluginsConfigs[key], output)
+}
+
+// DecodePluginConfig strictly decodes imported plugin config by key to output.
+// Unknown keys are reported with imported file lines.
+func (ic ImportedPluginsConfigs) DecodePluginConfig(key string, output interface{}) error {
+	return decodePluginConfig(ic.Path, ic.Source, key, ic.PluginsConfigs[key], output)
 }
 
 func (gc *GenerateConfig) ParseImports() error {
@@ -41,6 +60,7 @@ func (gc *GenerateConfig) ParseImports() error {
 		importedPluginsConfig := ImportedPluginsConfigs{
 			Path:           normalizedPath,
 			PluginsConfigs: pluginsConfig,
+			Source:         cfg,
 		}
 
 		err = yaml.Unmarshal(cfg, pluginsConfig)
@@ -0,0 +1,74 @@
+package generator
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/pkg/errors"
+)
+
+// UnknownConfigKeysError is returned, when config contains keys, which are not used by plugin.
+// Usually it's a typo in key name.
+type UnknownConfigKeysError struct {
+	Keys []string // e.g. "proto2gql.files[0].services[UserService].unwrap_field (generate.yml:12)"
+}
+
+func (e UnknownConfigKeysError) Error() string {
+	return "unknown config keys:\n\t" + strings.Join(e.Keys, "\n\t")
+}
+
+func decodePluginConfig(path string, source []byte, key string, input, output interface{}) error {
+	metadata := new(mapstructure.Metadata)
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		DecodeHook: mapstructure.ComposeDecodeHookFunc(
+			mapstructure.StringToTimeDurationHookFunc(),
+		),
+		Metadata: metadata,
+		Result:   output,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to create config decoder")
+	}
+	if err := decoder.Decode(input); err != nil {
+		return err
+	}
+	if len(metadata.Unused) == 0 {
+		return nil
+	}
+
+	type unknownKey struct {
+		name string
+		line int
+	}
+	paths := configPaths(input)
+	keys := make([]unknownKey, len(metadata.Unused))
+	for i, unused := range metadata.Unused {
+		keys[i].name = key + "." + unused
+		if strings.HasPrefix(unused, "[") {
+			keys[i].name = key + unused
+		}
+		if keyPath, ok := paths[strings.ToLower(unused)]; ok {
+			keys[i].line = yamlLine(source, append([]configPathSegment{{key: key}}, keyPath...))
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].line != keys[j].line {
+			return keys[i].line < keys[j].line
+		}
+
+		return keys[i].name < keys[j].name
+	})
+
+	res := UnknownConfigKeysError{}
+	for _, k := range keys {
+		if k.line > 0 {
+			res.Keys = append(res.Keys, fmt.Sprintf("%s (%s:%d)", k.name, path, k.line))
+		} else {
+			res.Keys = append(res.Keys, k.name)
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,66 @@
+package generator
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+	yaml "gopkg.in/yaml.v2"
+)
+
+const testConfig = `
+vendor_path: "./vendor"
+proto2gql:
+  output_path: "./out"
+  files:
+    - proto_path: "./api.proto"
+      services:
+        "UserService":
+          methods:
+            GetUser:
+              request_typ: "QUERY"
+    - proto_path: "./other.proto"
+      unwrap_fields: true
+`
+
+type testMethodConfig struct {
+	RequestType  string        `mapstructure:"request_type"`
+	WaitDuration time.Duration `mapstructure:"wait_duration"`
+}
+
+type testFileConfig struct {
+	ProtoPath string `mapstructure:"proto_path"`
+	Services  map[string]struct {
+		Methods map[string]testMethodConfig `mapstructure:"methods"`
+	} `mapstructure:"services"`
+}
+
+type testConfigStruct struct {
+	OutputPath string           `mapstructure:"output_path"`
+	Files      []testFileConfig `mapstructure:"files"`
+}
+
+func TestDecodePluginConfig(t *testing.T) {
+	Convey("Test DecodePluginConfig", t, func() {
+		gc := &GenerateConfig{
+			Path:   "generate.yml",
+			Source: []byte(testConfig),
+		}
+		So(yaml.Unmarshal(gc.Source, gc), ShouldBeNil)
+
+		Convey("Should report unknown keys with lines", func() {
+			err := gc.DecodePluginConfig("proto2gql", new(testConfigStruct))
+			So(err, ShouldHaveSameTypeAs, UnknownConfigKeysError{})
+			So(err.(UnknownConfigKeysError).Keys, ShouldResemble, []string{
+				"proto2gql.files[0].services[UserService].methods[GetUser].request_typ (generate.yml:11)",
+				"proto2gql.files[1].unwrap_fields (generate.yml:13)",
+			})
+		})
+		Convey("Should decode durations", func() {
+			gc.PluginsConfigs = PluginsConfigs{"m": map[interface{}]interface{}{"wait_duration": "10ms"}}
+			cfg := new(testMethodConfig)
+			So(gc.DecodePluginConfig("m", cfg), ShouldBeNil)
+			So(cfg.WaitDuration, ShouldEqual, 10*time.Millisecond)
+		})
+	})
+}
@@ -0,0 +1,220 @@
+package generator
+
+import (
+	"bufio"
+	"bytes"
+	"strconv"
+	"strings"
+)
+
+// configPathSegment is mapping key or sequence index of yaml node.
+type configPathSegment struct {
+	key     string
+	index   int
+	isIndex bool
+}
+
+// configPaths returns paths of all config nodes by their names in mapstructure errors and metadata format,
+// e.g. `files[0].services[UserService].methods`. Names are lower cased, because mapstructure matches
+// struct fields case insensitively.
+func configPaths(value interface{}) map[string][]configPathSegment {
+	res := make(map[string][]configPathSegment)
+
+	var walk func(name string, value interface{}, path []configPathSegment)
+	walk = func(name string, value interface{}, path []configPathSegment) {
+		switch v := value.(type) {
+		case map[interface{}]interface{}:
+			for key, val := range v {
+				k := configKeyString(key)
+				keyPath := append(path[:len(path):len(path)], configPathSegment{key: k})
+				names := []string{name + "[" + k + "]"}
+				if name == "" {
+					names = append(names, k)
+				} else {
+					names = append(names, name+"."+k)
+				}
+				for _, n := range names {
+					res[strings.ToLower(n)] = keyPath
+					walk(n, val, keyPath)
+				}
+			}
+		case map[string]interface{}:
+			m := make(map[interface{}]interface{}, len(v))
+			for key, val := range v {
+				m[key] = val
+			}
+			walk(name, m, path)
+		case []interface{}:
+			for i, val := range v {
+				indexPath := append(path[:len(path):len(path)], configPathSegment{index: i, isIndex: true})
+				n := name + "[" + strconv.Itoa(i) + "]"
+				res[strings.ToLower(n)] = indexPath
+				walk(n, val, indexPath)
+			}
+		}
+	}
+	walk("", value, nil)
+
+	return res
+}
+
+func configKeyString(key interface{}) string {
+	switch k := key.(type) {
+	case string:
+		return k
+	case int:
+		return strconv.Itoa(k)
+	case bool:
+		return strconv.FormatBool(k)
+	}
+
+	return ""
+}
+
+// yamlNode is a node of block style yaml document with line number of it's key or sequence item.
+type yamlNode struct {
+	line  int
+	keys  map[string]*yamlNode
+	items []*yamlNode
+}
+
+type yamlToken struct {
+	line int
+	col  int
+	text string
+}
+
+// yamlLine returns line number of node by path in yaml document or 0, if node was not found.
+// Only block style mappings and sequences are supported, which is enough for generate configs.
+func yamlLine(src []byte, path []configPathSegment) int {
+	toks := yamlTokens(src)
+	root, _ := parseYAMLNode(toks, 0, 0)
+	node := root
+	for _, segment := range path {
+		if node == nil {
+			return 0
+		}
+		if segment.isIndex {
+			if segment.index >= len(node.items) {
+				return 0
+			}
+			node = node.items[segment.index]
+		} else {
+			node = node.keys[segment.key]
+		}
+	}
+	if node == nil {
+		return 0
+	}
+
+	return node.line
+}
+
+// yamlTokens splits lines to tokens. Sequence item indicators are separate tokens, so `- key: value`
+// line becomes `-` and `key: value` tokens.
+func yamlTokens(src []byte) []yamlToken {
+	var res []yamlToken
+	scanner := bufio.NewScanner(bytes.NewReader(src))
+	for line := 1; scanner.Scan(); line++ {
+		text := scanner.Text()
+		col := len(text) - len(strings.TrimLeft(text, " "))
+		text = strings.TrimSpace(text)
+		if text == "" || strings.HasPrefix(text, "#") || text == "---" {
+			continue
+		}
+		for text == "-" || strings.HasPrefix(text, "- ") {
+			res = append(res, yamlToken{line: line, col: col, text: "-"})
+			rest := strings.TrimLeft(text[1:], " ")
+			col += len(text) - len(rest)
+			text = rest
+		}
+		if text != "" {
+			res = append(res, yamlToken{line: line, col: col, text: text})
+		}
+	}
+
+	return res
+}
+
+func parseYAMLNode(toks []yamlToken, pos, minCol int) (*yamlNode, int) {
+	if pos >= len(toks) || toks[pos].col < minCol {
+		return nil, pos
+	}
+	col := toks[pos].col
+	node := &yamlNode{line: toks[pos].line}
+
+	if toks[pos].text == "-" {
+		for pos < len(toks) && toks[pos].col == col && toks[pos].text == "-" {
+			line := toks[pos].line
+			var item *yamlNode
+			item, pos = parseYAMLNode(toks, pos+1, col+1)
+			if item == nil {
+				item = new(yamlNode)
+			}
+			item.line = line
+			node.items = append(node.items, item)
+		}
+
+		return node, pos
+	}
+
+	node.keys = make(map[string]*yamlNode)
+	for pos < len(toks) && toks[pos].col == col {
+		key, value, ok := splitYAMLKey(toks[pos].text)
+		if !ok {
+			// scalar or flow value
+			return node, skipYAMLTokens(toks, pos+1, col)
+		}
+		line := toks[pos].line
+		child := new(yamlNode)
+		pos++
+		if value != "" && !strings.HasPrefix(value, "#") {
+			pos = skipYAMLTokens(toks, pos, col)
+		} else if pos < len(toks) && toks[pos].col == col && toks[pos].text == "-" {
+			// sequence may have the same indentation as it's key
+			child, pos = parseYAMLNode(toks, pos, col)
+		} else if next, p := parseYAMLNode(toks, pos, col+1); next != nil {
+			child, pos = next, p
+		}
+		child.line = line
+		node.keys[key] = child
+	}
+
+	return node, pos
+}
+
+func skipYAMLTokens(toks []yamlToken, pos, col int) int {
+	for pos < len(toks) && toks[pos].col > col {
+		pos++
+	}
+
+	return pos
+}
+
+// splitYAMLKey splits `key: value` mapping entry.
+func splitYAMLKey(text string) (key, value string, ok bool) {
+	if strings.HasPrefix(text, `"`) || strings.HasPrefix(text, `'`) {
+		quote := text[:1]
+		end := strings.Index(text[1:], quote)
+		if end == -1 || !strings.HasPrefix(text[end+2:], ":") {
+			return "", "", false
+		}
+		key = text[1 : end+1]
+		if quote == `"` {
+			if unquoted, err := strconv.Unquote(text[:end+2]); err == nil {
+				key = unquoted
+			}
+		}
+
+		return key, strings.TrimSpace(text[end+3:]), true
+	}
+	if strings.HasSuffix(text, ":") {
+		return text[:len(text)-1], "", true
+	}
+	i := strings.Index(text, ": ")
+	if i == -1 || strings.HasPrefix(text, "[") || strings.HasPrefix(text, "{") {
+		return "", "", false
+	}
+
+	return text[:i], strings.TrimSpace(text[i+2:]), true
+}
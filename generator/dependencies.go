@@ -0,0 +1,129 @@
+package generator
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// PluginWithDependencies is optional interface of plugin, which must be processed after another plugins.
+type PluginWithDependencies interface {
+	// Dependencies returns names of plugins, which are initialized, prepared and generated before plugin.
+	Dependencies() []string
+}
+
+// PluginWithDependents is optional interface of plugin, which must be processed before another plugins.
+// E.g. external plugin, which should be generated before some of default plugins.
+type PluginWithDependents interface {
+	// Dependents returns names of plugins, which are initialized, prepared and generated after plugin.
+	Dependents() []string
+}
+
+// sortPlugins sorts plugins topologically by their dependencies.
+// Independent plugins keep registration order.
+func sortPlugins(plugins []Plugin) ([]Plugin, error) {
+	indexes := make(map[string]int, len(plugins))
+	for i, plugin := range plugins {
+		indexes[plugin.Name()] = i
+	}
+
+	// next[i] contains indexes of plugins, which must be processed after plugins[i].
+	next := make([][]int, len(plugins))
+	inDegree := make([]int, len(plugins))
+	addEdge := func(from, to int) {
+		next[from] = append(next[from], to)
+		inDegree[to]++
+	}
+
+	for i, plugin := range plugins {
+		if p, ok := plugin.(PluginWithDependencies); ok {
+			for _, name := range p.Dependencies() {
+				j, ok := indexes[name]
+				if !ok {
+					return nil, errors.Errorf("plugin %s depends on plugin %s, which is not registered", plugin.Name(), name)
+				}
+				addEdge(j, i)
+			}
+		}
+		if p, ok := plugin.(PluginWithDependents); ok {
+			for _, name := range p.Dependents() {
+				j, ok := indexes[name]
+				if !ok {
+					return nil, errors.Errorf("plugin %s must be processed before plugin %s, which is not registered", plugin.Name(), name)
+				}
+				addEdge(i, j)
+			}
+		}
+	}
+
+	res := make([]Plugin, 0, len(plugins))
+	processed := make([]bool, len(plugins))
+	for len(res) < len(plugins) {
+		ready := -1
+		for i := range plugins {
+			if !processed[i] && inDegree[i] == 0 {
+				ready = i
+				break
+			}
+		}
+		if ready == -1 {
+			return nil, errors.Errorf("plugins dependencies cycle: %s", pluginsCycle(plugins, next, processed))
+		}
+		processed[ready] = true
+		res = append(res, plugins[ready])
+		for _, j := range next[ready] {
+			inDegree[j]--
+		}
+	}
+
+	return res, nil
+}
+
+// pluginsCycle returns description of dependencies cycle between not processed plugins.
+func pluginsCycle(plugins []Plugin, next [][]int, processed []bool) string {
+	const (
+		notVisited = iota
+		inStack
+		visited
+	)
+	state := make([]int, len(plugins))
+	var stack []int
+	var cycle []string
+
+	var visit func(i int) bool
+	visit = func(i int) bool {
+		state[i] = inStack
+		stack = append(stack, i)
+		for _, j := range next[i] {
+			if processed[j] {
+				continue
+			}
+			if state[j] == inStack {
+				for k := len(stack) - 1; k >= 0; k-- {
+					cycle = append([]string{plugins[stack[k]].Name()}, cycle...)
+					if stack[k] == j {
+						break
+					}
+				}
+				cycle = append(cycle, plugins[j].Name())
+
+				return true
+			}
+			if state[j] == notVisited && visit(j) {
+				return true
+			}
+		}
+		stack = stack[:len(stack)-1]
+		state[i] = visited
+
+		return false
+	}
+
+	for i := range plugins {
+		if !processed[i] && state[i] == notVisited && visit(i) {
+			break
+		}
+	}
+
+	return strings.Join(cycle, " -> ")
+}
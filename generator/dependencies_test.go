@@ -0,0 +1,70 @@
+package generator
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+type testPlugin struct {
+	name         string
+	dependencies []string
+	dependents   []string
+}
+
+func (p testPlugin) Init(*GenerateConfig, []Plugin) error { return nil }
+func (p testPlugin) Prepare() error                       { return nil }
+func (p testPlugin) Name() string                         { return p.name }
+func (p testPlugin) PrintInfo(info Infos)                 {}
+func (p testPlugin) Infos() map[string]string             { return nil }
+func (p testPlugin) Generate() error                      { return nil }
+func (p testPlugin) Dependencies() []string               { return p.dependencies }
+func (p testPlugin) Dependents() []string                 { return p.dependents }
+
+func pluginsNames(plugins []Plugin) []string {
+	var res []string
+	for _, plugin := range plugins {
+		res = append(res, plugin.Name())
+	}
+
+	return res
+}
+
+func TestSortPlugins(t *testing.T) {
+	Convey("Test sortPlugins", t, func() {
+		Convey("Should keep registration order of independent plugins", func() {
+			plugins, err := sortPlugins([]Plugin{
+				testPlugin{name: "a"},
+				testPlugin{name: "b"},
+				testPlugin{name: "c"},
+			})
+			So(err, ShouldBeNil)
+			So(pluginsNames(plugins), ShouldResemble, []string{"a", "b", "c"})
+		})
+		Convey("Should process dependencies first", func() {
+			plugins, err := sortPlugins([]Plugin{
+				testPlugin{name: "proto", dependencies: []string{"graphql", "dataloader"}},
+				testPlugin{name: "graphql"},
+				testPlugin{name: "dataloader", dependents: []string{"graphql"}},
+				testPlugin{name: "external", dependents: []string{"proto"}},
+			})
+			So(err, ShouldBeNil)
+			So(pluginsNames(plugins), ShouldResemble, []string{"dataloader", "graphql", "external", "proto"})
+		})
+		Convey("Should report missing dependency", func() {
+			_, err := sortPlugins([]Plugin{
+				testPlugin{name: "a", dependencies: []string{"b"}},
+			})
+			So(err, ShouldBeError, "plugin a depends on plugin b, which is not registered")
+		})
+		Convey("Should report dependencies cycle", func() {
+			_, err := sortPlugins([]Plugin{
+				testPlugin{name: "a"},
+				testPlugin{name: "b", dependencies: []string{"c"}},
+				testPlugin{name: "c", dependencies: []string{"d"}},
+				testPlugin{name: "d", dependencies: []string{"b"}},
+			})
+			So(err, ShouldBeError, "plugins dependencies cycle: b -> d -> c -> b")
+		})
+	})
+}
@@ -44,12 +44,21 @@ func (g *Generator) RegisterPlugin(p Plugin) error {
 	return nil
 }
 func (g *Generator) Init() error {
+	plugins, err := sortPlugins(g.Plugins)
+	if err != nil {
+		return errors.Wrap(err, "failed to resolve plugins order")
+	}
+	g.Plugins = plugins
+
 	for _, plugin := range g.Plugins {
 		err := plugin.Init(g.Config, g.Plugins)
 		if err != nil {
 			return errors.Wrapf(err, "failed to initialize plugin %s", plugin.Name())
 		}
 	}
+	if err := g.validateTemplates(); err != nil {
+		return errors.Wrap(err, "invalid templates overrides")
+	}
 	return nil
 }
 
@@ -60,17 +69,61 @@ func (g *Generator) Prepare() error {
 			return errors.Wrapf(err, "failed to prepare plugin %s", plugin.Name())
 		}
 	}
+	for _, plugin := range g.Plugins {
+		if p, ok := plugin.(PluginWithConfigValidation); ok {
+			if err := p.ValidateConfig(); err != nil {
+				return errors.Wrapf(err, "plugin %s config is invalid", plugin.Name())
+			}
+		}
+	}
 	return nil
 }
 
 func (g *Generator) Generate() error {
+	output, err := NewOutput(g.outputRoots())
+	if err != nil {
+		return errors.Wrap(err, "failed to initialize output")
+	}
+
+	return g.GenerateOutput(output)
+}
+
+// Check generates files in memory and returns changes, which generation would make on disk.
+func (g *Generator) Check() ([]FileChange, error) {
+	output, err := NewDryRunOutput(g.outputRoots())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to initialize output")
+	}
+	if err := g.GenerateOutput(output); err != nil {
+		return nil, err
+	}
+
+	return output.Changes(), nil
+}
+
+// GenerateOutput runs plugins generation and writes generated files to output.
+func (g *Generator) GenerateOutput(output *Output) error {
+	g.Config.Output = output
+
 	for _, plugin := range g.Plugins {
 		err := plugin.Generate()
 		if err != nil {
 			return errors.Wrapf(err, "plugin %s generation errors", plugin.Name())
 		}
 	}
-	return nil
+
+	return errors.Wrap(output.Finish(), "failed to finish output")
+}
+
+func (g *Generator) outputRoots() []string {
+	var res []string
+	for _, plugin := range g.Plugins {
+		if p, ok := plugin.(PluginWithOutputRoots); ok {
+			res = append(res, p.OutputRoots()...)
+		}
+	}
+
+	return res
 }
 func (g *Generator) PrintInfos(i []string) {
 	for _, p := range g.Plugins {
@@ -0,0 +1,425 @@
+package generator
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	ManifestFileName = ".go2gql-manifest.json"
+	ManifestVersion  = 1
+)
+
+// PluginWithOutputRoots is optional interface of plugin, which generates files to configured directories.
+type PluginWithOutputRoots interface {
+	// OutputRoots returns directories, which generated files are placed to.
+	OutputRoots() []string
+}
+
+// File is a file, generated by plugin.
+type File struct {
+	Path    string
+	Plugin  string // name of plugin, which generated file
+	Source  string // file, which generated file was generated from
+	Content []byte
+}
+
+// FileChange is a change of file on disk, made by generation.
+type FileChange struct {
+	Path    string
+	Old     []byte // content on disk
+	New     []byte // generated content
+	Created bool
+	Removed bool
+}
+
+// Manifest lists files, generated to output root. It's stored in ManifestFileName file of the root.
+type Manifest struct {
+	Version int            `json:"version"`
+	Files   []ManifestFile `json:"files"`
+}
+
+type ManifestFile struct {
+	Path   string `json:"path"` // relative to output root
+	Plugin string `json:"plugin"`
+	Source string `json:"source,omitempty"`
+	Hash   string `json:"sha256"`
+}
+
+// Output writes generated files and keeps manifests of output roots. Files, which are not listed in manifest
+// and are not marked as generated, are never overwritten. Files, which were generated earlier, but were not
+// generated during the last generation, are removed by Finish.
+type Output struct {
+	roots     []string             // absolute paths, the longest first
+	manifests map[string]*Manifest // previous manifests by root
+	files     map[string]File      // generated files by absolute path
+	dryRun    bool
+	changes   map[string]FileChange // changes, which were not applied in dry run mode, by absolute path
+}
+
+func NewOutput(roots []string) (*Output, error) {
+	return newOutput(roots, false)
+}
+
+// NewDryRunOutput returns output, which doesn't change files on disk, but collects changes.
+func NewDryRunOutput(roots []string) (*Output, error) {
+	return newOutput(roots, true)
+}
+
+func newOutput(roots []string, dryRun bool) (*Output, error) {
+	o := &Output{
+		manifests: make(map[string]*Manifest),
+		files:     make(map[string]File),
+		dryRun:    dryRun,
+		changes:   make(map[string]FileChange),
+	}
+	for _, root := range roots {
+		if root == "" {
+			continue
+		}
+		abs, err := filepath.Abs(root)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to resolve output root %s absolute path", root)
+		}
+		if _, ok := o.manifests[abs]; ok {
+			continue
+		}
+		if _, err := o.manifest(abs); err != nil {
+			return nil, err
+		}
+		o.roots = append(o.roots, abs)
+	}
+	sort.Slice(o.roots, func(i, j int) bool {
+		if len(o.roots[i]) != len(o.roots[j]) {
+			return len(o.roots[i]) > len(o.roots[j])
+		}
+
+		return o.roots[i] < o.roots[j]
+	})
+
+	return o, nil
+}
+
+// WriteFile writes generated file. Nil output writes file without manifests checks.
+func (o *Output) WriteFile(file File) error {
+	if o == nil {
+		return WriteFile(file.Path, file.Content)
+	}
+
+	path, err := filepath.Abs(file.Path)
+	if err != nil {
+		return errors.Wrapf(err, "failed to resolve file %s absolute path", file.Path)
+	}
+	if err := o.checkOverwrite(path, file.Content); err != nil {
+		return err
+	}
+	if err := o.write(path, file.Content); err != nil {
+		return err
+	}
+	o.files[path] = file
+
+	return nil
+}
+
+// Files returns generated files sorted by path.
+func (o *Output) Files() []File {
+	res := make([]File, 0, len(o.files))
+	for _, file := range o.files {
+		res = append(res, file)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Path < res[j].Path
+	})
+
+	return res
+}
+
+// Changes returns changes of files on disk, which were collected in dry run mode, sorted by path.
+func (o *Output) Changes() []FileChange {
+	res := make([]FileChange, 0, len(o.changes))
+	for _, change := range o.changes {
+		res = append(res, change)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Path < res[j].Path
+	})
+
+	return res
+}
+
+// Finish removes stale files, which were generated earlier, and writes manifests of output roots.
+func (o *Output) Finish() error {
+	if o == nil {
+		return nil
+	}
+
+	files := make(map[string][]ManifestFile)
+	for path, file := range o.files {
+		root := o.root(path)
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return errors.Wrapf(err, "failed to resolve file %s path relative to output root", path)
+		}
+		files[root] = append(files[root], ManifestFile{
+			Path:   filepath.ToSlash(rel),
+			Plugin: file.Plugin,
+			Source: manifestSource(root, file.Source),
+			Hash:   contentHash(file.Content),
+		})
+	}
+
+	roots := make([]string, 0, len(o.manifests))
+	for root := range o.manifests {
+		roots = append(roots, root)
+	}
+	sort.Strings(roots)
+
+	var modified []string
+	for _, root := range roots {
+		for _, prev := range o.manifests[root].Files {
+			path := filepath.Join(root, filepath.FromSlash(prev.Path))
+			if _, ok := o.files[path]; ok {
+				continue
+			}
+			removed, err := o.removeStaleFile(root, path, prev.Hash)
+			if err != nil {
+				return err
+			}
+			if !removed {
+				modified = append(modified, path)
+			}
+		}
+	}
+
+	for _, root := range roots {
+		if err := o.writeManifest(root, files[root]); err != nil {
+			return err
+		}
+	}
+
+	if len(modified) > 0 {
+		return errors.Errorf("stale generated files were modified, remove them manually:\n\t%s", strings.Join(modified, "\n\t"))
+	}
+
+	return nil
+}
+
+// root returns the nearest output root of file or file directory, if file is not placed in any root.
+func (o *Output) root(path string) string {
+	for _, root := range o.roots {
+		if strings.HasPrefix(path, root+string(filepath.Separator)) {
+			return root
+		}
+	}
+
+	root := filepath.Dir(path)
+	if _, ok := o.manifests[root]; !ok {
+		// errors are reported when file is written
+		_, _ = o.manifest(root)
+	}
+
+	return root
+}
+
+// manifest returns previous manifest of root.
+func (o *Output) manifest(root string) (*Manifest, error) {
+	if m, ok := o.manifests[root]; ok {
+		return m, nil
+	}
+
+	m := new(Manifest)
+	data, err := ioutil.ReadFile(filepath.Join(root, ManifestFileName))
+	if err != nil && !os.IsNotExist(err) {
+		return nil, errors.Wrapf(err, "failed to read output root %s manifest", root)
+	}
+	if err == nil {
+		if err := json.Unmarshal(data, m); err != nil {
+			return nil, errors.Wrapf(err, "failed to unmarshal output root %s manifest", root)
+		}
+		if m.Version != ManifestVersion {
+			return nil, errors.Errorf("output root %s manifest version %d is not supported", root, m.Version)
+		}
+	}
+	o.manifests[root] = m
+
+	return m, nil
+}
+
+// checkOverwrite returns error, if existing file was not generated.
+func (o *Output) checkOverwrite(path string, content []byte) error {
+	existing, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrapf(err, "failed to read existing file %s", path)
+	}
+	if bytes.Equal(existing, content) || isGenerated(existing) {
+		return nil
+	}
+
+	root := o.root(path)
+	m, err := o.manifest(root)
+	if err != nil {
+		return err
+	}
+	for _, file := range m.Files {
+		if filepath.Join(root, filepath.FromSlash(file.Path)) == path {
+			return nil
+		}
+	}
+
+	return errors.Errorf("file %s was not generated by go2gql, refusing to overwrite it", path)
+}
+
+// isGenerated checks, if file content starts with go2gql `DO NOT EDIT` comment. Such files could be generated
+// before manifests were introduced.
+func isGenerated(content []byte) bool {
+	line, err := bufio.NewReader(bytes.NewReader(content)).ReadString('\n')
+	if err != nil && line == "" {
+		return false
+	}
+
+	return strings.HasPrefix(line, "//") && strings.Contains(line, "github.com/EGT-Ukraine/go2gql") && strings.Contains(line, "DO NOT EDIT")
+}
+
+// removeStaleFile removes file, if it was not modified after generation, and empty directories up to root.
+func (o *Output) removeStaleFile(root, path, hash string) (bool, error) {
+	content, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return true, nil
+	}
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to read stale file %s", path)
+	}
+	if contentHash(content) != hash {
+		return false, nil
+	}
+	if err := o.remove(path); err != nil {
+		return false, errors.Wrap(err, "failed to remove stale file")
+	}
+	if o.dryRun {
+		return true, nil
+	}
+	for dir := filepath.Dir(path); strings.HasPrefix(dir, root+string(filepath.Separator)); dir = filepath.Dir(dir) {
+		if os.Remove(dir) != nil {
+			// directory is not empty
+			break
+		}
+	}
+
+	return true, nil
+}
+
+func (o *Output) writeManifest(root string, files []ManifestFile) error {
+	path := filepath.Join(root, ManifestFileName)
+	if len(files) == 0 {
+		return errors.Wrapf(o.remove(path), "failed to remove output root %s manifest", root)
+	}
+
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].Path < files[j].Path
+	})
+	data, err := json.MarshalIndent(Manifest{Version: ManifestVersion, Files: files}, "", "  ")
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal output root %s manifest", root)
+	}
+
+	return o.write(path, append(data, '\n'))
+}
+
+// write writes file or adds it's change in dry run mode.
+func (o *Output) write(path string, content []byte) error {
+	if !o.dryRun {
+		return WriteFile(path, content)
+	}
+
+	existing, err := ioutil.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return errors.Wrapf(err, "failed to read file %s", path)
+	}
+	if err == nil && bytes.Equal(existing, content) {
+		delete(o.changes, path)
+
+		return nil
+	}
+	o.changes[path] = FileChange{
+		Path:    path,
+		Old:     existing,
+		New:     content,
+		Created: err != nil,
+	}
+
+	return nil
+}
+
+// remove removes file, if it exists, or adds it's change in dry run mode.
+func (o *Output) remove(path string) error {
+	existing, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrapf(err, "failed to read file %s", path)
+	}
+	if o.dryRun {
+		o.changes[path] = FileChange{
+			Path:    path,
+			Old:     existing,
+			Removed: true,
+		}
+
+		return nil
+	}
+
+	return errors.Wrapf(os.Remove(path), "failed to remove file %s", path)
+}
+
+// manifestSource returns source path relative to root, so manifests don't depend on working directory.
+func manifestSource(root, source string) string {
+	if source == "" {
+		return ""
+	}
+	abs, err := filepath.Abs(source)
+	if err != nil {
+		return filepath.ToSlash(source)
+	}
+	rel, err := filepath.Rel(root, abs)
+	if err != nil {
+		return filepath.ToSlash(source)
+	}
+
+	return filepath.ToSlash(rel)
+}
+
+func contentHash(content []byte) string {
+	hash := sha256.Sum256(content)
+
+	return hex.EncodeToString(hash[:])
+}
+
+// WriteFile writes generated file, creating it's directories.
+// File is not rewritten, if it already has the same content, so unchanged files keep their modification time.
+func WriteFile(path string, content []byte) error {
+	if existing, err := ioutil.ReadFile(path); err == nil && bytes.Equal(existing, content) {
+		return nil
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0777); err != nil {
+		return errors.Wrapf(err, "failed to create directories for file %s", path)
+	}
+	if err := ioutil.WriteFile(path, content, 0666); err != nil {
+		return errors.Wrapf(err, "failed to write file %s", path)
+	}
+
+	return nil
+}
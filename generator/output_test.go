@@ -0,0 +1,97 @@
+package generator
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestOutput(t *testing.T) {
+	Convey("Test Output", t, func() {
+		dir, err := ioutil.TempDir("", "go2gql-output")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(dir)
+
+		generate := func(paths ...string) error {
+			output, err := NewOutput([]string{dir})
+			if err != nil {
+				return err
+			}
+			for _, path := range paths {
+				err := output.WriteFile(File{
+					Path:    filepath.Join(dir, path),
+					Plugin:  "test",
+					Source:  filepath.Join(dir, "api.proto"),
+					Content: []byte("// " + path),
+				})
+				if err != nil {
+					return err
+				}
+			}
+
+			return output.Finish()
+		}
+		So(generate("a.go", "b/b.go"), ShouldBeNil)
+
+		Convey("Should write manifest", func() {
+			data, err := ioutil.ReadFile(filepath.Join(dir, ManifestFileName))
+			So(err, ShouldBeNil)
+			m := new(Manifest)
+			So(json.Unmarshal(data, m), ShouldBeNil)
+			So(m.Version, ShouldEqual, ManifestVersion)
+			So(m.Files, ShouldHaveLength, 2)
+			So(m.Files[0].Path, ShouldEqual, "a.go")
+			So(m.Files[0].Source, ShouldEqual, "api.proto")
+			So(m.Files[1].Path, ShouldEqual, "b/b.go")
+		})
+		Convey("Should remove stale files and empty directories", func() {
+			So(generate("a.go"), ShouldBeNil)
+			_, err := os.Stat(filepath.Join(dir, "b"))
+			So(os.IsNotExist(err), ShouldBeTrue)
+			_, err = os.Stat(filepath.Join(dir, "a.go"))
+			So(err, ShouldBeNil)
+		})
+		Convey("Should keep modified stale files", func() {
+			So(ioutil.WriteFile(filepath.Join(dir, "b", "b.go"), []byte("modified"), 0666), ShouldBeNil)
+			So(generate("a.go"), ShouldNotBeNil)
+			_, err := os.Stat(filepath.Join(dir, "b", "b.go"))
+			So(err, ShouldBeNil)
+		})
+		Convey("Should overwrite generated files", func() {
+			So(ioutil.WriteFile(filepath.Join(dir, "a.go"), []byte("modified"), 0666), ShouldBeNil)
+			So(generate("a.go", "b/b.go"), ShouldBeNil)
+		})
+		Convey("Should collect changes without writing in dry run mode", func() {
+			output, err := NewDryRunOutput([]string{dir})
+			So(err, ShouldBeNil)
+			So(output.WriteFile(File{Path: filepath.Join(dir, "a.go"), Content: []byte("// a.go")}), ShouldBeNil)
+			So(output.WriteFile(File{Path: filepath.Join(dir, "c.go"), Content: []byte("// c.go")}), ShouldBeNil)
+			So(output.Finish(), ShouldBeNil)
+
+			changes := output.Changes()
+			So(changes, ShouldHaveLength, 3)
+			So(changes[0].Path, ShouldEqual, filepath.Join(dir, ManifestFileName))
+			So(changes[1].Path, ShouldEqual, filepath.Join(dir, "b", "b.go"))
+			So(changes[1].Removed, ShouldBeTrue)
+			So(changes[2].Path, ShouldEqual, filepath.Join(dir, "c.go"))
+			So(changes[2].Created, ShouldBeTrue)
+			So(string(changes[2].New), ShouldEqual, "// c.go")
+
+			_, err = os.Stat(filepath.Join(dir, "c.go"))
+			So(os.IsNotExist(err), ShouldBeTrue)
+			_, err = os.Stat(filepath.Join(dir, "b", "b.go"))
+			So(err, ShouldBeNil)
+		})
+		Convey("Should refuse to overwrite not generated files", func() {
+			So(ioutil.WriteFile(filepath.Join(dir, "c.go"), []byte("package c"), 0666), ShouldBeNil)
+			So(generate("a.go", "b/b.go", "c.go"), ShouldNotBeNil)
+			content, err := ioutil.ReadFile(filepath.Join(dir, "c.go"))
+			So(err, ShouldBeNil)
+			So(string(content), ShouldEqual, "package c")
+		})
+	})
+}
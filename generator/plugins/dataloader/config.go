@@ -1,11 +1,42 @@
 package dataloader
 
+import "github.com/EGT-Ukraine/go2gql/generator/plugins/graphql"
+
 type DataLoadersConfig struct {
 	OutputPath string `mapstructure:"output_path"`
 }
 
 type FieldConfig struct {
-	FieldName    string `mapstructure:"field_name"`
-	KeyFieldName string `mapstructure:"key_field_name"`
-	DataLoader   string `mapstructure:"data_loader_name"`
+	FieldName     string           `mapstructure:"field_name"`
+	KeyFieldName  string           `mapstructure:"key_field_name"`  // key field path. Nested fields are separated by dot
+	KeyFieldNames []string         `mapstructure:"key_field_names"` // composite key fields paths
+	DataLoader    string           `mapstructure:"data_loader_name"`
+	Args          []FieldArgConfig `mapstructure:"args"`
+}
+
+type FieldArgConfig struct {
+	Name         string `mapstructure:"name"`          // graphql argument name
+	RequestField string `mapstructure:"request_field"` // loader request field path, which is filled by argument
+}
+
+// KeyFields returns paths of key fields in parent object.
+func (c FieldConfig) KeyFields() []string {
+	if len(c.KeyFieldNames) > 0 {
+		return c.KeyFieldNames
+	}
+
+	return []string{c.KeyFieldName}
+}
+
+// FieldArgs returns graphql arguments of data loader field.
+func (c FieldConfig) FieldArgs() []graphql.DataLoaderArg {
+	var res []graphql.DataLoaderArg
+	for _, arg := range c.Args {
+		res = append(res, graphql.DataLoaderArg{
+			Name:         arg.Name,
+			RequestField: arg.RequestField,
+		})
+	}
+
+	return res
 }
@@ -4,20 +4,33 @@ import (
 	"bytes"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"os"
-	"text/template"
+	"path/filepath"
+	"reflect"
 	"time"
 
-	"github.com/EGT-Ukraine/dataloaden/pkg/generator"
+	dataloaden "github.com/EGT-Ukraine/dataloaden/pkg/generator"
 	"github.com/pkg/errors"
 	"golang.org/x/tools/imports"
 
+	"github.com/EGT-Ukraine/go2gql/generator"
 	"github.com/EGT-Ukraine/go2gql/generator/plugins/graphql"
 	"github.com/EGT-Ukraine/go2gql/generator/plugins/graphql/lib/importer"
 )
 
+const DataLoaderPkgPath = "github.com/EGT-Ukraine/go2gql/api/dataloader"
+
 const DefaultWaitDuration = 10 * time.Millisecond
 
+const loadersFileName = "loaders.go"
+
+const (
+	CacheNone    = "none"
+	CacheRequest = "request"
+	CacheTTL     = "ttl"
+)
+
 type LoadersHeadContext struct {
 	Imports []importer.Import
 }
@@ -29,44 +42,112 @@ type LoadersBodyContext struct {
 
 type Loader struct {
 	LoaderTypeName    string
+	KeyTypeName       string
+	KeyFields         []KeyField
+	Args              []Arg
+	ArgsTypeName      string
+	ArgsKeyTypeName   string
+	BaseKeyGoType     graphql.GoType // key go type without arguments
+	BaseKeysGoType    graphql.GoType
 	Service           Service
 	FetchCode         string
 	RequestGoType     graphql.GoType
+	KeyGoType         graphql.GoType
 	ResponseGoType    graphql.GoType
 	OutputGraphqlType graphql.TypeResolver
 	Name              string
 	WaitDuration      time.Duration
+	MaxBatch          int
+	Cache             string
+	CacheTTL          time.Duration
+	Lazy              bool
+	Primed            bool
+	PrimeKey          string // key of primed `value`
 }
 
 type LoaderGenerator struct {
-	dataLoader *DataLoader
-	importer   *importer.Importer
+	dataLoader  *DataLoader
+	importer    *importer.Importer
+	generateCfg *generator.GenerateConfig
 }
 
-func NewLoaderGenerator(dataLoader *DataLoader) *LoaderGenerator {
-	return &LoaderGenerator{dataLoader: dataLoader, importer: &importer.Importer{}}
+func NewLoaderGenerator(dataLoader *DataLoader, generateCfg *generator.GenerateConfig) *LoaderGenerator {
+	return &LoaderGenerator{dataLoader: dataLoader, importer: &importer.Importer{}, generateCfg: generateCfg}
 }
 
 func (p *LoaderGenerator) GenerateDataLoaders() error {
-	if err := os.MkdirAll(p.dataLoader.OutputPath, os.ModePerm); err != nil {
-		return errors.Wrap(err, "failed to create output path dir "+p.dataLoader.OutputPath)
+	loaders, err := p.generateSchemaLoaders()
+	if err != nil {
+		return err
 	}
 
-	if err := p.generateSchemaLoaders(); err != nil {
-		return err
+	// dataloaden writes files itself, so loaders are generated to temporary directory and then written to output.
+	// Directory is created in the same go package tree, so dataloaden resolves packages as in output directory.
+	tmpDir, err := ioutil.TempDir(existingDir(filepath.Dir(p.dataLoader.OutputPath)), "go2gql-loaders")
+	if err != nil {
+		return errors.Wrap(err, "failed to create temporary loaders directory")
+	}
+	defer os.RemoveAll(tmpDir)
+
+	if err := ioutil.WriteFile(filepath.Join(tmpDir, loadersFileName), loaders, 0666); err != nil {
+		return errors.Wrap(err, "failed to write temporary loaders file")
 	}
 
-	for _, dataLoader := range p.dataLoader.Loaders {
-		if err := p.generateLoaders(dataLoader.OutputGraphqlTypeName, dataLoader.InputGoType, dataLoader.OutputGoType, dataLoader.Slice); err != nil {
+	for _, name := range p.dataLoader.loadersNames() {
+		dataLoader := p.dataLoader.Loaders[name]
+		if dataLoader.Cache == CacheNone {
+			// loaders without cache are generated in loaders file.
+			continue
+		}
+		if err := p.generateLoaders(dataLoader.loaderBaseName(), dataLoader.KeyGoType(), dataLoader.OutputGoType, dataLoader.Slice, tmpDir); err != nil {
 			return errors.Wrapf(err, "failed to generate %s data loader", dataLoader.Name)
 		}
 	}
 
+	files, err := ioutil.ReadDir(tmpDir)
+	if err != nil {
+		return errors.Wrap(err, "failed to read temporary loaders directory")
+	}
+	for _, file := range files {
+		if file.IsDir() || file.Name() == loadersFileName {
+			continue
+		}
+		content, err := ioutil.ReadFile(filepath.Join(tmpDir, file.Name()))
+		if err != nil {
+			return errors.Wrapf(err, "failed to read generated loader file %s", file.Name())
+		}
+		err = p.generateCfg.Output.WriteFile(generator.File{
+			Path:    filepath.Join(p.dataLoader.OutputPath, file.Name()),
+			Plugin:  PluginName,
+			Content: content,
+		})
+		if err != nil {
+			return errors.Wrapf(err, "failed to write generated loader %s file", file.Name())
+		}
+	}
+
 	return nil
 }
 
-func (p *LoaderGenerator) generateLoaders(outputGraphqlTypeName string, requestGoType graphql.GoType, responseGoType graphql.GoType, slice bool) (rerr error) {
-	keyType := requestGoType.ElemType.Kind.String()
+// existingDir returns the nearest existing directory of path.
+func existingDir(path string) string {
+	for {
+		if info, err := os.Stat(path); err == nil && info.IsDir() {
+			return path
+		}
+		parent := filepath.Dir(path)
+		if parent == path {
+			return ""
+		}
+		path = parent
+	}
+}
+
+func (p *LoaderGenerator) generateLoaders(outputGraphqlTypeName string, keyGoType graphql.GoType, responseGoType graphql.GoType, slice bool, dir string) (rerr error) {
+	keyType := keyGoType.Kind.String()
+	if keyGoType.Kind == reflect.Struct {
+		keyType = keyGoType.Name
+	}
 
 	var typeName string
 
@@ -81,47 +162,38 @@ func (p *LoaderGenerator) generateLoaders(outputGraphqlTypeName string, requestG
 		}
 	}()
 
-	if err := generator.Generate(outputGraphqlTypeName, typeName, keyType, slice, true, p.dataLoader.OutputPath); err != nil {
+	if err := dataloaden.Generate(outputGraphqlTypeName, typeName, keyType, slice, true, dir); err != nil {
 		return errors.Wrapf(err, "Failed to generate loader for '%s'", typeName)
 	}
 
 	return nil
 }
 
-func (p *LoaderGenerator) generateSchemaLoaders() error {
-	path := p.dataLoader.OutputPath + "/loaders.go"
+// generateSchemaLoaders writes loaders.go file and returns it's content.
+func (p *LoaderGenerator) generateSchemaLoaders() ([]byte, error) {
+	path := filepath.Join(p.dataLoader.OutputPath, loadersFileName)
 
-	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0666)
+	out := new(bytes.Buffer)
 
-	if err != nil {
-		return errors.Wrapf(err, "failed to open loaders %s output file for write", path)
+	if err := p.renderLoaders(out); err != nil {
+		return nil, errors.Wrapf(err, "failed to generate loaders file %s", path)
 	}
 
-	err = p.renderLoaders(file)
-
+	err := p.generateCfg.Output.WriteFile(generator.File{
+		Path:    path,
+		Plugin:  PluginName,
+		Content: out.Bytes(),
+	})
 	if err != nil {
-		if cerr := file.Close(); cerr != nil {
-			err = errors.Wrap(err, cerr.Error())
-		}
-
-		return errors.Wrapf(err, "failed to generate loaders file %s", path)
-	}
-
-	if file.Close(); err != nil {
-		return errors.Wrapf(err, "failed to close generated loaders %s file", path)
+		return nil, errors.Wrapf(err, "failed to write generated loaders %s file", path)
 	}
 
-	return nil
+	return out.Bytes(), nil
 }
 
 func (p *LoaderGenerator) generateBody() ([]byte, error) {
 	buf := new(bytes.Buffer)
 
-	tmpl, err := templatesLoaders_bodyGohtmlBytes()
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to get loaders template")
-	}
-
 	importFunc := func(importPath string) func() string {
 		return func() string {
 			return p.importer.New(importPath)
@@ -129,7 +201,9 @@ func (p *LoaderGenerator) generateBody() ([]byte, error) {
 	}
 
 	templateFuncs := map[string]interface{}{
-		"timePkg": importFunc("time"),
+		"timePkg":       importFunc("time"),
+		"syncPkg":       importFunc("sync"),
+		"dataloaderPkg": importFunc(DataLoaderPkgPath),
 		"goType": func(typ graphql.GoType) string {
 			return typ.String(p.importer)
 		},
@@ -142,44 +216,70 @@ func (p *LoaderGenerator) generateBody() ([]byte, error) {
 		},
 	}
 
-	servicesTpl, err := template.New("loaders_body").Funcs(templateFuncs).Parse(string(tmpl))
+	servicesTpl, err := p.generateCfg.ParseTemplate("loaders_body.gohtml", Asset, templateFuncs)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to parse template")
 	}
 
 	var loaders []Loader
 
-	for _, dataLoaderModel := range p.dataLoader.Loaders {
+	for _, name := range p.dataLoader.loadersNames() {
+		dataLoaderModel := p.dataLoader.Loaders[name]
 		service := dataLoaderModel.Service
 
-		requestGoType := dataLoaderModel.InputGoType
+		requestGoType := dataLoaderModel.keysGoType()
 
 		responseGoType := dataLoaderModel.OutputGoType
 
-		loaderTypeName := dataLoaderModel.OutputGraphqlTypeName
+		loaderTypeName := dataLoaderModel.loaderBaseName()
 
 		if dataLoaderModel.Slice {
 			loaderTypeName += "Slice"
 		}
 
+		var primeKey string
+		if dataLoaderModel.Primed {
+			primeKey = dataLoaderModel.MatchKey("value", p.importer)
+
+			if len(dataLoaderModel.Args) > 0 {
+				// values are primed for key without arguments.
+				primeKey = dataLoaderModel.ArgsKeyTypeName() + "{Key: " + primeKey + "}"
+			}
+		}
+
 		loaders = append(loaders, Loader{
-			LoaderTypeName: loaderTypeName + "Loader",
-			Service:        *service,
-			FetchCode:      dataLoaderModel.FetchCode(p.importer),
-			RequestGoType:  requestGoType,
-			ResponseGoType: responseGoType,
-			Name:           dataLoaderModel.Name,
-			WaitDuration:   dataLoaderModel.WaitDuration,
+			LoaderTypeName:  loaderTypeName + "Loader",
+			KeyTypeName:     dataLoaderModel.KeyTypeName(),
+			KeyFields:       dataLoaderModel.KeyFields,
+			Service:         *service,
+			Args:            dataLoaderModel.Args,
+			ArgsTypeName:    dataLoaderModel.ArgsTypeName(),
+			ArgsKeyTypeName: dataLoaderModel.ArgsKeyTypeName(),
+			BaseKeyGoType:   dataLoaderModel.baseKeyGoType(),
+			BaseKeysGoType:  dataLoaderModel.baseKeysGoType(),
+			FetchCode:       dataLoaderModel.FetchCode(p.importer, dataLoaderModel.Args),
+			RequestGoType:   requestGoType,
+			KeyGoType:       *requestGoType.ElemType,
+			ResponseGoType:  responseGoType,
+			Name:            dataLoaderModel.Name,
+			WaitDuration:    dataLoaderModel.WaitDuration,
+			MaxBatch:        dataLoaderModel.MaxBatch,
+			Cache:           dataLoaderModel.Cache,
+			CacheTTL:        dataLoaderModel.CacheTTL,
+			Lazy:            dataLoaderModel.Lazy,
+			Primed:          dataLoaderModel.Primed,
+			PrimeKey:        primeKey,
 		})
 	}
 
 	servicesSet := map[Service]struct{}{}
+	var services []Service
 	for _, loader := range loaders {
+		if _, ok := servicesSet[loader.Service]; ok {
+			continue
+		}
 		servicesSet[loader.Service] = struct{}{}
-	}
-	var services []Service
-	for service := range servicesSet {
-		services = append(services, service)
+		services = append(services, loader.Service)
 	}
 
 	context := LoadersBodyContext{
@@ -197,11 +297,7 @@ func (p *LoaderGenerator) generateBody() ([]byte, error) {
 
 func (p *LoaderGenerator) generateHead() ([]byte, error) {
 	buf := new(bytes.Buffer)
-	tmpl, err := templatesLoaders_headGohtmlBytes()
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to get head template")
-	}
-	bodyTpl, err := template.New("loaders_head").Parse(string(tmpl))
+	bodyTpl, err := p.generateCfg.ParseTemplate("loaders_head.gohtml", Asset, nil)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to parse template")
 	}
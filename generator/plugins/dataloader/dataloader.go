@@ -1,6 +1,8 @@
 package dataloader
 
 import (
+	"reflect"
+	"sort"
 	"time"
 
 	"github.com/pkg/errors"
@@ -15,6 +17,18 @@ type DataLoader struct {
 	Loaders    map[string]LoaderModel
 }
 
+// loadersNames returns sorted names of loaders. Loaders should be generated in this order,
+// so generated code doesn't depend on map iteration order.
+func (d *DataLoader) loadersNames() []string {
+	res := make([]string, 0, len(d.Loaders))
+	for name := range d.Loaders {
+		res = append(res, name)
+	}
+	sort.Strings(res)
+
+	return res
+}
+
 type Service struct {
 	Name          string
 	CallInterface graphql.GoType
@@ -29,8 +43,112 @@ type LoaderModel struct {
 	OutputGoType          graphql.GoType
 	OutputGraphqlType     graphql.TypeResolver
 	OutputGraphqlTypeName string
-	FetchCode             func(importer *importer.Importer) string
+	FetchCode             func(importer *importer.Importer, args []Arg) string
 	Slice                 bool
+	MaxBatch              int           // max keys count in one fetch. Bigger batches are split into concurrent fetches.
+	Cache                 string        // none | request | ttl
+	CacheTTL              time.Duration // shared between requests cache values ttl. Used only with ttl cache.
+	Lazy                  bool          // create loader only when it's used in request.
+	KeyFields             []KeyField    // composite key fields. Empty for scalar keys.
+	// RequestArg resolves request field, which can be filled by data loader field argument.
+	// Nil, if loader doesn't support arguments.
+	RequestArg func(requestField string) (*Arg, error)
+	Args       []Arg // arguments of all fields, which use loader. Filled by plugin.
+	// MatchKey returns key of loaded value. Used to prime loader cache.
+	// Nil, if loader can't be primed.
+	MatchKey func(value string, importer *importer.Importer) string
+	Primed   bool // generate DataLoaders.Prime<Name> method. Filled by plugin.
+}
+
+type KeyField struct {
+	Name   string
+	GoType graphql.GoType
+}
+
+type Arg struct {
+	Name         string // name of field in generated args struct
+	RequestField string
+	GoType       graphql.GoType
+	GraphQLType  graphql.TypeResolver
+	Value        graphql.ValueResolver // resolves arg value from graphql argument
+	WithErr      bool                  // Value resolver returns (value, error)
+}
+
+// KeyTypeName returns name of generated composite key struct.
+func (l LoaderModel) KeyTypeName() string {
+	return l.Name + "Key"
+}
+
+// ArgsTypeName returns name of generated arguments struct.
+func (l LoaderModel) ArgsTypeName() string {
+	return l.Name + "Args"
+}
+
+// ArgsKeyTypeName returns name of generated struct, which combines key with arguments.
+func (l LoaderModel) ArgsKeyTypeName() string {
+	return l.Name + "ArgsKey"
+}
+
+func (l LoaderModel) arg(requestField string) (Arg, bool) {
+	for _, arg := range l.Args {
+		if arg.RequestField == requestField {
+			return arg, true
+		}
+	}
+
+	return Arg{}, false
+}
+
+// KeyGoType returns go type of loader key.
+func (l LoaderModel) KeyGoType() graphql.GoType {
+	if len(l.Args) > 0 {
+		return graphql.GoType{
+			Kind: reflect.Struct,
+			Name: l.ArgsKeyTypeName(),
+		}
+	}
+
+	return l.baseKeyGoType()
+}
+
+// baseKeyGoType returns go type of loader key without arguments.
+func (l LoaderModel) baseKeyGoType() graphql.GoType {
+	if len(l.KeyFields) > 0 {
+		return graphql.GoType{
+			Kind: reflect.Struct,
+			Name: l.KeyTypeName(),
+		}
+	}
+
+	return *l.InputGoType.ElemType
+}
+
+func (l LoaderModel) keysGoType() graphql.GoType {
+	keyGoType := l.KeyGoType()
+
+	return graphql.GoType{
+		Kind:     reflect.Slice,
+		ElemType: &keyGoType,
+	}
+}
+
+func (l LoaderModel) baseKeysGoType() graphql.GoType {
+	keyGoType := l.baseKeyGoType()
+
+	return graphql.GoType{
+		Kind:     reflect.Slice,
+		ElemType: &keyGoType,
+	}
+}
+
+// loaderBaseName returns name, which is used to generate loader type name.
+func (l LoaderModel) loaderBaseName() string {
+	if len(l.KeyFields) > 0 || len(l.Args) > 0 {
+		// Loaders with composite keys can't be shared with other loaders of the same type.
+		return l.Name
+	}
+
+	return l.OutputGraphqlTypeName
 }
 
 func (p *Plugin) createDataLoader(config *DataLoadersConfig, vendorPath string) (*DataLoader, error) {
@@ -2,15 +2,17 @@ package dataloader
 
 import (
 	"bytes"
-	"text/template"
+	"strconv"
 
 	"github.com/pkg/errors"
 
+	"github.com/EGT-Ukraine/go2gql/generator"
 	"github.com/EGT-Ukraine/go2gql/generator/plugins/graphql"
 )
 
 type fieldsRenderer struct {
-	dataLoader *DataLoader
+	dataLoader  *DataLoader
+	generateCfg *generator.GenerateConfig
 }
 
 func (r *fieldsRenderer) RenderFields(o graphql.OutputObject, ctx graphql.BodyContext) (string, error) {
@@ -27,6 +29,86 @@ func (r *fieldsRenderer) RenderFields(o graphql.OutputObject, ctx graphql.BodyCo
 		"loadersPkg": func() string {
 			return ctx.Importer.New(r.dataLoader.Pkg)
 		},
+		"loaderKey": func(field graphql.DataLoaderField, arg, argsVar string) string {
+			dataLoader := r.dataLoader.Loaders[field.DataLoaderName]
+			loadersPkg := ctx.Importer.Prefix(r.dataLoader.Pkg)
+
+			var key string
+			if len(dataLoader.KeyFields) == 0 {
+				key = field.KeyFields[0].Value(arg, ctx)
+			} else {
+				key = loadersPkg + dataLoader.KeyTypeName() + "{\n"
+				for i, keyField := range dataLoader.KeyFields {
+					key += keyField.Name + ": " + field.KeyFields[i].Value(arg, ctx) + ",\n"
+				}
+				key += "}"
+			}
+
+			if len(dataLoader.Args) == 0 {
+				return key
+			}
+
+			if field.KeyFieldSlice {
+				return loadersPkg + dataLoader.ArgsKeyTypeName() + "s(" + key + ", " + argsVar + ")"
+			}
+
+			return loadersPkg + dataLoader.ArgsKeyTypeName() + "{Key: " + key + ", Args: " + argsVar + "}"
+		},
+		"loaderKeyParsed": func(field graphql.DataLoaderField) bool {
+			for _, keyField := range field.KeyFields {
+				if keyField.Parsed {
+					return true
+				}
+			}
+
+			return false
+		},
+		"loaderArgs": func(field graphql.DataLoaderField, argsVar, paramsArgs string) string {
+			dataLoader := r.dataLoader.Loaders[field.DataLoaderName]
+
+			if len(dataLoader.Args) == 0 {
+				return ""
+			}
+
+			res := argsVar + " := " + ctx.Importer.Prefix(r.dataLoader.Pkg) + dataLoader.ArgsTypeName() + "{}\n"
+			for _, fieldArg := range field.Args {
+				arg, _ := dataLoader.arg(fieldArg.RequestField)
+				value := paramsArgs + "[" + strconv.Quote(fieldArg.Name) + "]"
+				if arg.WithErr {
+					res += "if " + value + " != nil {\n" +
+						"v, err := " + arg.Value(value, ctx) + "\n" +
+						"if err != nil {\n" +
+						"return nil, err\n" +
+						"}\n" +
+						argsVar + "." + arg.Name + " = v\n" +
+						"}\n"
+
+					continue
+				}
+				res += "if " + value + " != nil {\n" +
+					argsVar + "." + arg.Name + " = " + arg.Value(value, ctx) + "\n" +
+					"}\n"
+			}
+
+			return res
+		},
+		"loaderFieldArgs": func(field graphql.DataLoaderField) string {
+			dataLoader := r.dataLoader.Loaders[field.DataLoaderName]
+
+			if len(field.Args) == 0 {
+				return ""
+			}
+
+			res := ctx.Importer.New(graphql.GraphqlPkgPath) + ".FieldConfigArgument{\n"
+			for _, fieldArg := range field.Args {
+				arg, _ := dataLoader.arg(fieldArg.RequestField)
+				res += strconv.Quote(fieldArg.Name) + ": &" + ctx.Importer.New(graphql.GraphqlPkgPath) + ".ArgumentConfig{\n" +
+					"Type: " + arg.GraphQLType(ctx) + ",\n" +
+					"},\n"
+			}
+
+			return res + "}"
+		},
 		"graphqlOutputLoaderTypeName": func(ctx graphql.BodyContext, dataLoaderFieldConfig graphql.DataLoaderField) string {
 			dataLoaderConfig := r.dataLoader.Loaders[dataLoaderFieldConfig.DataLoaderName]
 
@@ -41,11 +123,7 @@ func (r *fieldsRenderer) RenderFields(o graphql.OutputObject, ctx graphql.BodyCo
 	}
 
 	buf := new(bytes.Buffer)
-	tmpl, err := templatesOutput_object_fieldsGohtmlBytes()
-	if err != nil {
-		return "", errors.Wrap(err, "failed to get output fields template")
-	}
-	bodyTpl, err := template.New("head").Funcs(templateFuncs).Parse(string(tmpl))
+	bodyTpl, err := r.generateCfg.ParseTemplate("output_object_fields.gohtml", Asset, templateFuncs)
 	if err != nil {
 		return "", errors.Wrap(err, "failed to parse template")
 	}
@@ -0,0 +1,163 @@
+package dataloader
+
+import (
+	"reflect"
+	"strconv"
+
+	"github.com/pkg/errors"
+
+	"github.com/EGT-Ukraine/go2gql/generator/plugins/graphql"
+)
+
+func isIntKind(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return true
+	}
+
+	return false
+}
+
+func isUintKind(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return true
+	}
+
+	return false
+}
+
+func isFloatKind(kind reflect.Kind) bool {
+	return kind == reflect.Float32 || kind == reflect.Float64
+}
+
+func isNumberKind(kind reflect.Kind) bool {
+	return isIntKind(kind) || isUintKind(kind) || isFloatKind(kind)
+}
+
+// keyErrVar is a variable of generated resolver, which gets the first key parse error.
+const keyErrVar = "keyErr"
+
+// numberKindBits returns size of number kind. int and uint are considered 64-bit.
+func numberKindBits(kind reflect.Kind) int {
+	switch kind {
+	case reflect.Int8, reflect.Uint8:
+		return 8
+	case reflect.Int16, reflect.Uint16:
+		return 16
+	case reflect.Int32, reflect.Uint32, reflect.Float32:
+		return 32
+	}
+
+	return 64
+}
+
+// floatMantissaBits returns count of integer bits, which float kind represents exactly.
+func floatMantissaBits(kind reflect.Kind) int {
+	if kind == reflect.Float32 {
+		return 24
+	}
+
+	return 53
+}
+
+// isWideningConversion reports, whether every `from` number kind value is represented exactly by `to` number kind.
+func isWideningConversion(from, to reflect.Kind) bool {
+	fromBits, toBits := numberKindBits(from), numberKindBits(to)
+	switch {
+	case isFloatKind(from):
+		return isFloatKind(to) && toBits >= fromBits
+	case isFloatKind(to):
+		return fromBits < floatMantissaBits(to)
+	case isIntKind(from):
+		return isIntKind(to) && toBits >= fromBits
+	}
+
+	return isUintKind(to) && toBits >= fromBits || isIntKind(to) && toBits > fromBits
+}
+
+// keyValueConverter returns resolver, which converts key value of `from` scalar type to `to` scalar type.
+// Keys of loaders from different sources(e.g. swagger loader, used by proto message) often have different types.
+// Numbers are only widened. Parsed strings resolvers store parse error to keyErrVar variable, so parsed is true for them.
+func keyValueConverter(from, to graphql.GoType) (convert graphql.ValueResolver, parsed bool, err error) {
+	switch {
+	case isNumberKind(from.Kind) && isNumberKind(to.Kind):
+		if !isWideningConversion(from.Kind, to.Kind) {
+			return nil, false, errors.Errorf("can't convert %s to %s without loss of values", from.Kind, to.Kind)
+		}
+
+		return func(arg string, ctx graphql.BodyContext) string {
+			return to.Kind.String() + "(" + arg + ")"
+		}, false, nil
+	case from.Kind == reflect.String && isIntKind(to.Kind):
+		return func(arg string, ctx graphql.BodyContext) string {
+			return to.Kind.String() + "(" + ctx.Importer.New(DataLoaderPkgPath) + ".ParseInt(" + arg + ", " + parseBitSize(to.Kind) + ", &" + keyErrVar + "))"
+		}, true, nil
+	case from.Kind == reflect.String && isUintKind(to.Kind):
+		return func(arg string, ctx graphql.BodyContext) string {
+			return to.Kind.String() + "(" + ctx.Importer.New(DataLoaderPkgPath) + ".ParseUint(" + arg + ", " + parseBitSize(to.Kind) + ", &" + keyErrVar + "))"
+		}, true, nil
+	case from.Kind == reflect.String && isFloatKind(to.Kind):
+		return func(arg string, ctx graphql.BodyContext) string {
+			return to.Kind.String() + "(" + ctx.Importer.New(DataLoaderPkgPath) + ".ParseFloat(" + arg + ", " + parseBitSize(to.Kind) + ", &" + keyErrVar + "))"
+		}, true, nil
+	case isIntKind(from.Kind) && to.Kind == reflect.String:
+		return func(arg string, ctx graphql.BodyContext) string {
+			return ctx.Importer.New("strconv") + ".FormatInt(int64(" + arg + "), 10)"
+		}, false, nil
+	case isUintKind(from.Kind) && to.Kind == reflect.String:
+		return func(arg string, ctx graphql.BodyContext) string {
+			return ctx.Importer.New("strconv") + ".FormatUint(uint64(" + arg + "), 10)"
+		}, false, nil
+	case isFloatKind(from.Kind) && to.Kind == reflect.String:
+		return func(arg string, ctx graphql.BodyContext) string {
+			return ctx.Importer.New("strconv") + ".FormatFloat(float64(" + arg + "), 'f', -1, 64)"
+		}, false, nil
+	}
+
+	return nil, false, errors.Errorf("can't convert %s to %s", from.Kind, to.Kind)
+}
+
+// parseBitSize returns strconv parse functions bit size of number kind.
+func parseBitSize(kind reflect.Kind) string {
+	if kind == reflect.Int || kind == reflect.Uint {
+		return "0"
+	}
+
+	return strconv.Itoa(numberKindBits(kind))
+}
+
+// convertedKeyField returns key field, which value is converted to loader key type.
+func convertedKeyField(keyField graphql.DataLoaderKeyField, loaderKeyType graphql.GoType) (graphql.DataLoaderKeyField, error) {
+	convert, parsed, err := keyValueConverter(keyField.GoType, loaderKeyType)
+	if err != nil {
+		return keyField, err
+	}
+
+	value := keyField.Value
+	keyField.GoType = loaderKeyType
+	keyField.Parsed = parsed
+	if !keyField.Repeated {
+		keyField.Value = func(arg string, ctx graphql.BodyContext) string {
+			return convert(value(arg, ctx), ctx)
+		}
+
+		return keyField, nil
+	}
+
+	keyField.Value = func(arg string, ctx graphql.BodyContext) string {
+		typ := loaderKeyType.Kind.String()
+
+		return `func() []` + typ + ` {
+			values := ` + value(arg, ctx) + `
+			res := make([]` + typ + `, len(values))
+			for i, value := range values {
+				res[i] = ` + convert("value", ctx) + `
+			}
+
+			return res
+		}()`
+	}
+
+	return keyField, nil
+}
@@ -0,0 +1,90 @@
+package dataloader
+
+import (
+	"reflect"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/EGT-Ukraine/go2gql/generator/plugins/graphql"
+	"github.com/EGT-Ukraine/go2gql/generator/plugins/graphql/lib/importer"
+)
+
+func TestConvertedKeyField(t *testing.T) {
+	Convey("Test convertedKeyField", t, func() {
+		ctx := graphql.BodyContext{Importer: &importer.Importer{}}
+		keyField := graphql.DataLoaderKeyField{
+			Path:   "user_id",
+			GoType: graphql.GoType{Scalar: true, Kind: reflect.Int32},
+			Value:  graphql.IdentAccessValueResolver("UserId"),
+		}
+
+		Convey("Should cast numbers", func() {
+			res, err := convertedKeyField(keyField, graphql.GoType{Scalar: true, Kind: reflect.Int64})
+			So(err, ShouldBeNil)
+			So(res.GoType.Kind, ShouldEqual, reflect.Int64)
+			So(res.Value("parent", ctx), ShouldEqual, "int64(parent.UserId)")
+		})
+		Convey("Should format numbers to strings", func() {
+			res, err := convertedKeyField(keyField, graphql.GoType{Scalar: true, Kind: reflect.String})
+			So(err, ShouldBeNil)
+			So(res.Value("parent", ctx), ShouldEqual, "strconv.FormatInt(int64(parent.UserId), 10)")
+		})
+		Convey("Should convert repeated keys values", func() {
+			keyField.Repeated = true
+			res, err := convertedKeyField(keyField, graphql.GoType{Scalar: true, Kind: reflect.Float64})
+			So(err, ShouldBeNil)
+			So(res.Value("parent", ctx), ShouldContainSubstring, "res[i] = float64(value)")
+		})
+		Convey("Should only widen numbers", func() {
+			widening := [][2]reflect.Kind{
+				{reflect.Int32, reflect.Int},
+				{reflect.Uint32, reflect.Uint64},
+				{reflect.Uint32, reflect.Int64},
+				{reflect.Int16, reflect.Float32},
+				{reflect.Uint32, reflect.Float64},
+				{reflect.Float32, reflect.Float64},
+			}
+			for _, kinds := range widening {
+				keyField.GoType = graphql.GoType{Scalar: true, Kind: kinds[0]}
+				_, err := convertedKeyField(keyField, graphql.GoType{Scalar: true, Kind: kinds[1]})
+				So(err, ShouldBeNil)
+			}
+			narrowing := [][2]reflect.Kind{
+				{reflect.Int64, reflect.Int32},
+				{reflect.Int32, reflect.Uint64},
+				{reflect.Uint32, reflect.Int32},
+				{reflect.Uint64, reflect.Uint16},
+				{reflect.Int32, reflect.Float32},
+				{reflect.Int64, reflect.Float64},
+				{reflect.Float64, reflect.Float32},
+				{reflect.Float32, reflect.Int64},
+			}
+			for _, kinds := range narrowing {
+				keyField.GoType = graphql.GoType{Scalar: true, Kind: kinds[0]}
+				_, err := convertedKeyField(keyField, graphql.GoType{Scalar: true, Kind: kinds[1]})
+				So(err, ShouldNotBeNil)
+			}
+		})
+		Convey("Should parse strings to numbers", func() {
+			keyField.GoType = graphql.GoType{Scalar: true, Kind: reflect.String}
+			res, err := convertedKeyField(keyField, graphql.GoType{Scalar: true, Kind: reflect.Int32})
+			So(err, ShouldBeNil)
+			So(res.Parsed, ShouldBeTrue)
+			So(res.Value("parent", ctx), ShouldEqual, "int32(dataloader.ParseInt(parent.UserId, 32, &keyErr))")
+
+			res, err = convertedKeyField(keyField, graphql.GoType{Scalar: true, Kind: reflect.Uint})
+			So(err, ShouldBeNil)
+			So(res.Value("parent", ctx), ShouldEqual, "uint(dataloader.ParseUint(parent.UserId, 0, &keyErr))")
+
+			res, err = convertedKeyField(keyField, graphql.GoType{Scalar: true, Kind: reflect.Float64})
+			So(err, ShouldBeNil)
+			So(res.Value("parent", ctx), ShouldEqual, "float64(dataloader.ParseFloat(parent.UserId, 64, &keyErr))")
+		})
+		Convey("Should not parse strings to not numbers", func() {
+			keyField.GoType = graphql.GoType{Scalar: true, Kind: reflect.String}
+			_, err := convertedKeyField(keyField, graphql.GoType{Scalar: true, Kind: reflect.Bool})
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
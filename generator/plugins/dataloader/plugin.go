@@ -2,8 +2,8 @@ package dataloader
 
 import (
 	"path/filepath"
+	"sort"
 
-	"github.com/mitchellh/mapstructure"
 	"github.com/pkg/errors"
 
 	"github.com/EGT-Ukraine/go2gql/generator"
@@ -21,13 +21,33 @@ type Plugin struct {
 	dataLoader        *DataLoader
 	dataLoaderConfigs *DataLoadersConfig
 
-	loaders map[string]LoaderModel
+	loaders       map[string]LoaderModel
+	primedLoaders map[string]struct{}
 }
 
 func (p *Plugin) AddLoader(loader LoaderModel) {
 	p.loaders[loader.Name] = loader
 }
 
+// Loader returns registered loader by name. Loaders, registered by any source plugin,
+// are available after all plugins are prepared.
+func (p *Plugin) Loader(name string) (LoaderModel, bool) {
+	loader, ok := p.loaders[name]
+
+	return loader, ok
+}
+
+// PrimeLoader requests generation of `DataLoaders.Prime<LoaderName>` method,
+// which puts loaded values to loader cache.
+func (p *Plugin) PrimeLoader(name string) {
+	p.primedLoaders[name] = struct{}{}
+}
+
+// LoadersPkg returns go package of generated loaders. Available after plugin Generate.
+func (p *Plugin) LoadersPkg() string {
+	return p.dataLoader.Pkg
+}
+
 func (p *Plugin) Prepare() error {
 	return nil
 }
@@ -50,7 +70,7 @@ func (p *Plugin) Init(config *generator.GenerateConfig, plugins []generator.Plug
 	var dataLoadersConfig DataLoadersConfig
 
 	if config.PluginsConfigs[DataLoadersConfigsKey] != nil {
-		if err := mapstructure.Decode(config.PluginsConfigs[DataLoadersConfigsKey], &dataLoadersConfig); err != nil {
+		if err := config.DecodePluginConfig(DataLoadersConfigsKey, &dataLoadersConfig); err != nil {
 			return errors.Wrap(err, "failed to decode dataloaders config")
 		}
 
@@ -66,6 +86,7 @@ func (p *Plugin) Init(config *generator.GenerateConfig, plugins []generator.Plug
 	}
 
 	p.loaders = make(map[string]LoaderModel)
+	p.primedLoaders = make(map[string]struct{})
 
 	return nil
 }
@@ -74,11 +95,23 @@ func (p Plugin) Name() string {
 	return PluginName
 }
 
+// Templates returns names of plugin templates, which could be overridden.
+func (p Plugin) Templates() []string {
+	return generator.TemplatesNames(AssetNames())
+}
+
+// Dependents returns plugins, which are processed after dataloader plugin.
+// Data loader fields renderer must be registered before graphql plugin generates types.
+func (p Plugin) Dependents() []string {
+	return []string{graphql.PluginName}
+}
+
 func (p *Plugin) validateOutputObjects(gqlFiles map[string]*graphql.TypesFile) error {
-	for _, gqlFile := range gqlFiles {
+	for _, path := range graphql.TypesFilesPaths(gqlFiles) {
+		gqlFile := gqlFiles[path]
 		for _, outputObject := range gqlFile.OutputObjects {
 			for _, dataLoaderField := range outputObject.DataLoaderFields {
-				dataLoader, ok := p.loaders[dataLoaderField.DataLoaderName]
+				dataLoader, ok := p.Loader(dataLoaderField.DataLoaderName)
 
 				if !ok {
 					return errors.Errorf(
@@ -88,27 +121,31 @@ func (p *Plugin) validateOutputObjects(gqlFiles map[string]*graphql.TypesFile) e
 					)
 				}
 
-				outputArgument := outputObject.FindFieldByName(dataLoaderField.ParentKeyFieldName)
-
-				if outputArgument == nil {
-					return errors.Errorf(
-						"Field `%s` not found in `%s`",
-						dataLoaderField.ParentKeyFieldName,
-						outputObject.GraphQLName,
-					)
+				if len(dataLoaderField.KeyFields) == 0 {
+					outputArgument := outputObject.FindFieldByName(dataLoaderField.ParentKeyFieldName)
+
+					if outputArgument == nil {
+						return errors.Errorf(
+							"Field `%s` not found in `%s`",
+							dataLoaderField.ParentKeyFieldName,
+							outputObject.GraphQLName,
+						)
+					}
+
+					dataLoaderField.KeyFields = []graphql.DataLoaderKeyField{{
+						Path:     dataLoaderField.ParentKeyFieldName,
+						GoType:   outputArgument.GoType,
+						Repeated: dataLoaderField.KeyFieldSlice,
+						Value:    graphql.IdentAccessValueResolver(dataLoaderField.NormalizedParentKeyFieldName),
+					}}
 				}
 
-				if !outputArgument.GoType.Scalar {
-					return errors.Errorf(
-						"Field `%s` in `%s` must be scalar",
-						dataLoaderField.ParentKeyFieldName,
-						outputObject.GraphQLName,
-					)
+				if err := p.validateDataLoaderFieldKey(dataLoader, dataLoaderField); err != nil {
+					return errors.Wrapf(err, "invalid `%s` data loader field `%s` key", outputObject.GraphQLName, dataLoaderField.Name)
 				}
 
-				if dataLoader.InputGoType.ElemType.Kind != outputArgument.GoType.Kind {
-					// TODO: use type casting if possible.
-					return errors.New("input argument must be same type as output")
+				if err := p.addDataLoaderFieldArgs(dataLoader.Name, dataLoaderField); err != nil {
+					return errors.Wrapf(err, "invalid `%s` data loader field `%s` arguments", outputObject.GraphQLName, dataLoaderField.Name)
 				}
 			}
 		}
@@ -117,6 +154,134 @@ func (p *Plugin) validateOutputObjects(gqlFiles map[string]*graphql.TypesFile) e
 	return nil
 }
 
+// addDataLoaderFieldArgs resolves data loader field arguments and adds them to loader arguments.
+func (p *Plugin) addDataLoaderFieldArgs(loaderName string, field *graphql.DataLoaderField) error {
+	dataLoader := p.loaders[loaderName]
+	argNames := make(map[string]struct{})
+
+	for _, fieldArg := range field.Args {
+		if fieldArg.Name == "" || fieldArg.RequestField == "" {
+			return errors.New("argument name and request field must be specified")
+		}
+
+		if _, ok := argNames[fieldArg.Name]; ok {
+			return errors.Errorf("duplicate argument `%s`", fieldArg.Name)
+		}
+		argNames[fieldArg.Name] = struct{}{}
+
+		if dataLoader.RequestArg == nil {
+			return errors.Errorf("data loader %s doesn't support arguments", dataLoader.Name)
+		}
+
+		if _, ok := dataLoader.arg(fieldArg.RequestField); ok {
+			continue
+		}
+
+		arg, err := dataLoader.RequestArg(fieldArg.RequestField)
+		if err != nil {
+			return errors.Wrapf(err, "failed to resolve argument `%s` request field", fieldArg.Name)
+		}
+
+		dataLoader.Args = append(dataLoader.Args, *arg)
+	}
+
+	sort.Slice(dataLoader.Args, func(i, j int) bool {
+		return dataLoader.Args[i].RequestField < dataLoader.Args[j].RequestField
+	})
+
+	p.loaders[loaderName] = dataLoader
+
+	return nil
+}
+
+func (p *Plugin) validateDataLoaderFieldKey(dataLoader LoaderModel, field *graphql.DataLoaderField) error {
+	var loaderKeyTypes []graphql.GoType
+	if len(dataLoader.KeyFields) > 0 {
+		for _, keyField := range dataLoader.KeyFields {
+			loaderKeyTypes = append(loaderKeyTypes, keyField.GoType)
+		}
+	} else {
+		loaderKeyTypes = append(loaderKeyTypes, *dataLoader.InputGoType.ElemType)
+	}
+
+	if len(loaderKeyTypes) != len(field.KeyFields) {
+		return errors.Errorf("data loader %s key has %d fields, but %d key fields was configured", dataLoader.Name, len(loaderKeyTypes), len(field.KeyFields))
+	}
+
+	for i, keyField := range field.KeyFields {
+		if keyField.Repeated && len(field.KeyFields) > 1 {
+			return errors.Errorf("composite key field `%s` can't be repeated", keyField.Path)
+		}
+
+		if !keyField.GoType.Scalar {
+			return errors.Errorf("Field `%s` must be scalar", keyField.Path)
+		}
+
+		if loaderKeyTypes[i].Kind != keyField.GoType.Kind {
+			convertedKeyField, err := convertedKeyField(keyField, loaderKeyTypes[i])
+			if err != nil {
+				return errors.Wrapf(err, "Field `%s` can't be used as data loader %s key", keyField.Path, dataLoader.Name)
+			}
+			field.KeyFields[i] = convertedKeyField
+		}
+	}
+
+	return nil
+}
+
+func (p *Plugin) validateLoaders() error {
+	for _, name := range p.dataLoader.loadersNames() {
+		loader := p.loaders[name]
+		switch loader.Cache {
+		case "", CacheNone, CacheRequest:
+		case CacheTTL:
+			if loader.CacheTTL <= 0 {
+				return errors.Errorf("dataloader %s: cache_ttl must be positive for ttl cache", loader.Name)
+			}
+		default:
+			return errors.Errorf("dataloader %s: invalid cache type(%s)", loader.Name, loader.Cache)
+		}
+
+		if loader.MaxBatch < 0 {
+			return errors.Errorf("dataloader %s: max_batch can't be negative", loader.Name)
+		}
+	}
+
+	primedLoaders := make([]string, 0, len(p.primedLoaders))
+	for name := range p.primedLoaders {
+		primedLoaders = append(primedLoaders, name)
+	}
+	sort.Strings(primedLoaders)
+	for _, name := range primedLoaders {
+		loader, ok := p.loaders[name]
+		if !ok {
+			return errors.Errorf("primed dataloader %s not found", name)
+		}
+
+		if loader.Slice || loader.MatchKey == nil {
+			return errors.Errorf("dataloader %s can't be primed. Only 1-1 loaders can be primed", name)
+		}
+
+		if loader.Cache == CacheNone {
+			return errors.Errorf("dataloader %s can't be primed, because it has no cache", name)
+		}
+
+		loader.Primed = true
+		p.loaders[name] = loader
+	}
+
+	return nil
+}
+
+// OutputRoots returns data loaders output path.
+func (p *Plugin) OutputRoots() []string {
+	if p.dataLoaderConfigs == nil {
+		return nil
+	}
+
+	return []string{p.dataLoaderConfigs.OutputPath}
+}
+
 func (p *Plugin) PrintInfo(info generator.Infos) {
 }
 
@@ -126,6 +291,10 @@ func (p *Plugin) Infos() map[string]string {
 
 func (p *Plugin) Generate() error {
 	if p.dataLoaderConfigs == nil {
+		if len(p.primedLoaders) > 0 {
+			return errors.New("data_loaders config is required to prime dataloaders")
+		}
+
 		return nil
 	}
 
@@ -138,14 +307,22 @@ func (p *Plugin) Generate() error {
 	p.dataLoader = dataLoader
 
 	p.gqlPlugin.AddOutputObjectFieldRenderer(&fieldsRenderer{
-		dataLoader: p.dataLoader,
+		dataLoader:  p.dataLoader,
+		generateCfg: p.generateCfg,
 	})
 
+	if err := p.validateLoaders(); err != nil {
+		return errors.Wrap(err, "failed to validate dataloaders")
+	}
+
 	if err := p.validateOutputObjects(p.gqlPlugin.Types()); err != nil {
 		return errors.Wrap(err, "failed to validate graphql files")
 	}
 
-	loaderGen := NewLoaderGenerator(dataLoader)
+	// loaders arguments are resolved with output objects
+	p.registerEntityDataLoaders()
+
+	loaderGen := NewLoaderGenerator(dataLoader, p.generateCfg)
 
 	if err := loaderGen.GenerateDataLoaders(); err != nil {
 		return errors.Wrap(err, "failed to generate data loader files")
@@ -153,3 +330,20 @@ func (p *Plugin) Generate() error {
 
 	return nil
 }
+
+// registerEntityDataLoaders registers loaders, which could resolve federation entities references:
+// 1-1 loaders without arguments and composite keys.
+func (p *Plugin) registerEntityDataLoaders() {
+	for _, name := range p.dataLoader.loadersNames() {
+		loader := p.dataLoader.Loaders[name]
+		if loader.Slice || len(loader.KeyFields) > 0 || len(loader.Args) > 0 {
+			continue
+		}
+		p.gqlPlugin.AddEntityDataLoader(graphql.EntityDataLoader{
+			Name:         loader.Name,
+			LoadersPkg:   p.dataLoader.Pkg,
+			KeyGoType:    loader.KeyGoType(),
+			OutputGoType: loader.OutputGoType,
+		})
+	}
+}
@@ -70,7 +70,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _templatesLoaders_bodyGohtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x53\xc1\x8e\xda\x30\x10\x3d\xdb\x5f\x31\x87\x55\x45\x10\x24\x52\x8f\x48\x5c\xca\x76\x51\xd5\xaa\x87\xee\xb6\x7b\x58\xed\xc1\x4d\x86\x60\xad\xb1\x59\x67\x42\x41\x56\xfe\xbd\x72\xec\x40\x60\x43\x7b\xd8\x13\x62\xe6\x65\xde\x9b\x37\xcf\xce\x4d\x21\x1b\x97\x86\x0e\x5b\x9c\x41\x29\x69\x5d\xff\x4e\x73\xb3\xc9\x3e\x2f\x1f\xa6\x3f\x5f\xac\x90\x1a\xb3\xd2\x7c\x2c\x5f\x55\x56\xa2\x46\x2b\xc8\xd8\x6c\xab\xea\x52\xea\x2a\x2b\x04\x09\x65\x44\x81\x36\xfd\xd6\xfe\x54\x9f\x4c\x71\x58\x18\x4d\xb8\xa7\x71\x06\xd3\xa6\xe1\xdc\x8f\x86\xd0\x5e\x28\x89\x9a\x2a\x90\x9a\xd0\xae\x44\x8e\xe0\x38\x73\xce\x0a\x5d\x22\xdc\x54\x68\x77\x32\x47\x98\xcd\xe1\x26\xbd\x0f\x7f\xaa\x76\x06\x63\x4b\x24\xe7\x3a\x44\xfa\x5d\x6c\xb0\x69\xc2\xb4\x51\x02\xce\x95\xe6\xc1\xb3\x1c\x01\x0b\xa1\xd4\x97\x8e\xc4\x0f\x70\x0e\x75\xd1\xce\xea\x14\xdd\x0a\x12\x51\x34\x54\x64\xeb\x9c\xce\xc4\x84\xb5\x82\x96\x0e\x16\xa4\x38\x17\x9b\x51\x46\xe8\xc2\xa9\x1c\x0a\x5e\x50\x00\x0c\xb1\x17\x27\xf6\x68\xd7\x57\x3c\xb4\x3b\x04\x2d\xae\xe1\x7c\x27\xec\x30\x0e\xe6\xd7\xbf\xf7\x1f\xae\x6a\x9d\xc3\x12\x29\x76\x1e\x25\xad\x23\x76\x94\xd3\x1e\xf2\x50\x4e\x63\x7b\x02\x62\x2b\xbb\xcb\x9c\xdd\x29\xb9\x84\x7a\x87\x7a\xcc\xde\x9d\x0f\x3d\x1f\xdf\xe3\xdf\x0c\x72\x8b\x82\xf0\xb2\xeb\x15\xf7\x15\xa6\x21\x09\x11\x73\x3f\x18\x88\x64\xd2\xb7\x9c\x35\x9c\x33\x8b\x54\x5b\x7d\xdc\xc7\x5b\xf2\x4b\xa8\x1a\xc3\xf8\x41\x37\xcf\xca\x89\xbf\xdc\x7f\x97\x6b\x8d\xbf\xbe\xc7\x5b\xe7\xf3\x56\x71\x2f\xc0\x17\x7b\x5d\xe4\x38\xf9\x47\xca\xfc\x6d\xe2\x96\xd7\x41\x8e\x33\xb6\x42\xca\xd7\x33\xf0\x5a\x47\x2f\x78\xa8\xde\xb2\xff\xc0\xd7\x1a\x2b\x5a\xb6\x55\xcf\x3a\x7a\x7a\x1e\x00\x55\x5b\xa3\x2b\xec\x50\x13\x78\x7a\x46\x6b\x8d\x4d\xbc\x92\xfe\x99\xef\x3c\xe1\xc2\x14\xed\x53\x60\xcd\x84\x33\xf6\x47\x48\x9a\x81\x73\x45\x6d\x05\x49\xa3\x8f\x53\x1f\x85\xa4\xdb\x58\x6c\x3c\xd4\x3f\x99\xd3\x2d\x8f\xd1\xee\xa5\xee\xce\x9a\x4d\x34\x74\xc8\xe4\x04\xc6\xfd\xa7\xee\x38\xdb\x09\xe5\x4f\x97\xd3\x3e\x0d\x19\x18\x3c\x7f\xc2\x39\x93\x2b\xf0\xe0\xf9\x1c\xb4\x54\xed\x5a\xd1\x61\x2d\xd5\x59\xae\x76\x42\xa5\xa3\x3e\x8f\xcf\xcb\xdf\x00\x00\x00\xff\xff\xdf\x92\xbc\xf6\x5c\x05\x00\x00")
+var _templatesLoaders_bodyGohtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5a\x5b\x6f\xd3\x48\x14\x7e\x4e\x7e\xc5\x2c\x5a\x21\xbb\xa4\x0e\xbb\x8f\x81\xae\x54\x0a\x54\x08\x16\x10\x74\x97\x87\x28\x42\x26\x99\x1a\xab\xae\x1d\x6c\xa7\x6d\xd6\xca\x7f\xdf\x73\x99\x9b\x1d\xdb\x49\xba\xc0\xd3\xf2\xd2\x78\xe6\xcc\xb9\x9f\x6f\xce\xcc\x50\x55\xc7\x62\x7c\x14\x65\xe5\x7a\x29\x27\x22\x8a\xcb\xaf\xab\x2f\xc1\x3c\xbb\x1e\xbf\x38\xbf\x38\xfe\xeb\x2a\x0f\xe3\x54\x8e\xa3\xec\xf7\xe8\x5b\x32\x8e\x64\x2a\xf3\xb0\xcc\xf2\xf1\x32\x59\x45\x71\x5a\x8c\x17\x61\x19\x26\x59\xb8\x90\x79\xf0\x86\xfe\x14\xcf\xb2\xc5\xfa\x2c\x4b\x4b\x79\x57\x1e\x8d\xc5\xf1\x66\x33\x1c\x22\x6b\xc1\xd3\x67\x49\x2c\xd3\xb2\x10\x31\x10\xe4\x97\xe1\x5c\x8a\x6a\x38\xa8\xaa\x3c\x4c\x23\x29\x7e\x2d\x64\x7e\x13\xc3\xd8\xe4\x44\xfc\x1a\x7c\xe4\x8f\x82\x78\x0c\x06\xe7\xb2\xac\x2a\x4d\x11\xbc\x0d\xaf\xe5\x66\xc3\xdc\x3c\x5f\x54\x55\x94\x5d\xa0\x14\x43\x70\x16\x26\xc9\x2b\x2d\x04\x19\x54\x95\x4c\x17\xc4\x4b\x6b\xf4\x1c\x74\x57\x4a\x8b\xa2\xcc\x57\xf3\x12\x95\x99\x97\x77\x02\xff\xcd\xd9\x86\x40\xd9\x02\x13\x4a\xf5\x9a\x21\x43\x47\x7b\xf6\x03\x2b\xaf\xf9\xb2\xee\x55\x25\xe2\x4b\x4d\x10\xbc\x09\xff\x59\xab\x89\x41\x02\xbf\xc1\x2c\x35\xc3\x56\xf1\x5a\x52\xe2\xc8\xce\xf1\x28\x1a\xc9\x54\xfd\xab\xdf\xa5\xe8\xda\xaa\x58\xa7\xf3\xf7\x57\xd1\x66\x13\xe0\x00\x6b\x22\x93\x42\x6a\xf1\x1d\xa2\x7b\xa5\x22\x0b\xe5\xc9\xba\x53\x77\x3a\xe2\x72\x95\xce\x85\x97\x88\x23\xc7\xf3\xbe\xe0\xc0\xb6\xa8\x01\x71\xed\xb1\x9f\x13\xa7\xc3\xaf\x49\xd0\xef\x9a\xe0\x79\xe6\xa1\x36\x98\x3a\xe4\x48\xa3\xf1\x3c\x97\x61\x29\x9b\x2b\xbd\x24\x80\xbc\x18\x09\xf8\xc3\x71\x0f\x6a\x5a\x7f\x6c\xcd\x4a\x9f\x38\xf7\x68\x22\x4e\xc4\x43\x1e\x06\xca\x8d\x0f\xb9\x34\xc8\x65\xb9\xca\x53\xd1\xb7\x6a\xd8\x08\xa2\x5a\xf2\x30\x09\xfa\xe8\xdd\x30\xb9\x4e\x7b\x9f\xc7\xd7\x92\xe7\xc6\x63\x41\x5f\x4d\x36\x62\xb9\x82\xb4\xbf\x09\x93\x15\x14\x63\x99\x89\xad\x79\xe5\xbd\x79\x38\xff\x2a\x83\x8e\x28\xb7\x72\xf6\x14\xd3\x20\x08\x6c\x05\x2b\x8a\x0f\xb2\x58\x66\x69\x21\xcf\x69\x78\xb3\xa1\x48\xd9\x38\x25\x41\x4f\xde\x0c\x07\x97\x59\x2e\x3e\x8f\x58\x69\x24\xe7\xcc\x54\xe2\x30\xe4\xe0\x02\x9e\x3c\x39\x11\x69\x9c\x70\x1a\x60\xcd\xc7\xe9\x0a\x0b\x05\x3d\x7b\x25\xd7\xb8\xd6\x4a\x21\x23\x5e\xcb\x35\xe7\x98\x33\xe6\x01\xa9\x92\xe6\x53\x89\x1c\xa3\x8f\xe5\x37\x63\xcd\x19\xfa\x46\x3c\x28\xcb\xe4\x81\x2a\xa2\xba\xe6\x34\x0f\x79\x54\xb6\x70\x82\xd8\xe1\x1a\x15\x3c\x1d\x49\xfb\x8b\xd1\x6c\x61\xbd\xad\x20\x0b\x14\x25\x8f\x32\xb6\x55\x40\x78\x13\xe6\xed\x74\x90\x89\x9d\xeb\x71\x21\xc5\x14\x1c\xae\x66\x3e\xc1\x2e\xa1\x68\x3d\xc4\xcb\x06\x56\x8e\x44\xb8\x8c\xcf\xda\xe0\xd2\x6f\x92\xa2\xdf\x1d\xc9\xe8\xee\x87\x4e\xde\x60\x54\x40\xc0\x84\x11\x19\x2a\x10\xbf\x99\xd5\xc4\x11\x32\x3a\x00\x85\xd3\xac\x6c\x45\xe2\xf6\x5c\x9a\x74\x01\x02\xc1\x81\xd5\x60\x2f\x3c\x18\x75\xa2\x27\x04\x77\xa8\x0b\x59\x7b\x08\x9d\xfc\x37\xe6\x01\xcb\x6a\x8d\x4f\x6d\xd8\xdf\x0b\x84\xeb\xe5\x0f\x3c\x5e\xc6\x32\x59\xf0\x1c\x25\x92\x35\x43\x25\x80\xaa\x72\xbb\x45\x02\x0b\x25\xe4\x12\xd7\x92\x8c\x56\x7e\xe4\x55\xa2\xd1\x50\x61\xcb\x9c\x87\x75\x75\x6f\x83\x94\xcd\x73\x57\xdf\xd3\x3c\x6a\x55\x15\xc7\x77\xe8\x1a\xe6\x91\xab\xa9\xe1\x44\x4a\xc2\xe4\xb6\x8a\x38\xd8\xa5\x60\x8b\xfc\x2e\x77\x61\x7d\x89\x2d\x7c\x7b\x16\x16\x08\x25\x96\x3f\x29\xd4\x65\xd1\x50\x17\x61\x8f\xc8\x02\xb1\xa3\xe8\x94\x54\x68\x51\x90\xb7\x7d\xa2\x7c\xd1\x82\xc5\xdf\x00\x37\x4b\xcd\x00\x8d\xca\x25\x15\xeb\x75\x78\x25\xbd\x5d\xf4\xb0\x6f\xca\x94\x94\xf3\x15\x34\xc7\x23\xa1\xc0\x95\x83\xc3\x8a\xd3\x66\x56\x4c\xe3\x99\x38\xe9\xb3\xb3\x82\xaf\x89\x20\x9c\xc4\xb9\x09\x99\x53\xaf\x21\x60\xd3\x96\x45\xdb\x80\x9c\x66\xa9\x7c\xa0\x77\xbf\x9e\x66\xe3\x4b\x58\x02\x7d\xc1\x8a\xde\x42\x65\x66\xab\x92\x37\xbc\x91\x28\x32\x21\x6f\x64\xbe\xa6\x7d\x50\x44\x12\x40\x2f\xbb\x4d\x19\xc3\x83\x66\xa2\x6c\x71\xb6\x79\xc2\x32\x72\x6c\x7a\x6c\x5b\xcd\xdd\xdb\x33\x9e\x32\x59\x80\xdb\x6b\x37\x4f\x9f\x40\x17\x1d\xbe\x1d\x4a\x27\xe5\x7c\xe1\xed\xdc\x75\x47\x42\xe6\x79\x96\xfb\x1c\x72\xd5\x9b\x20\xf7\x8b\xaf\xab\xf4\x0a\x45\xf8\x9e\x7f\x90\x5a\x66\xe1\x0e\xdd\x54\x7b\x76\x98\x8a\x25\x32\xe7\xf6\x40\x79\xb3\xa1\xac\x4d\x91\x7b\xf1\x1f\x50\x50\x69\x04\xa5\x90\x38\x6c\x37\x30\x6f\x47\xe2\x33\x8e\x71\xd8\x77\xb3\x75\xdb\x3d\x5a\x0d\x3c\xd5\x26\xbf\xb7\x2f\x4f\x93\xa4\xa3\xe6\x1b\x15\x08\x76\x4e\x67\x7b\x58\x3a\x9d\x75\x84\x1b\x24\x19\x27\x16\x87\x86\xbc\xb6\x78\x0f\x5d\x75\x68\x0e\x56\x99\xe2\x61\x71\x69\x3a\x3b\x38\xc8\x07\x40\x15\x0b\x63\xb4\x6a\xd6\x44\x0d\x8c\xee\x6d\x0e\x66\xd5\x2a\x29\x1d\x7b\xf6\x60\x80\xfa\xb3\x6a\x74\x00\x01\x6e\xae\x43\x88\xf9\x16\x91\xb2\xd3\x54\x0f\x5b\xaa\xbc\x49\x9d\x31\x6b\x02\xc6\x92\xa3\x94\xd5\x36\xfd\x37\xf5\x64\x06\x52\xa6\x6b\xe9\x5a\xbb\x1b\x63\x22\xc0\x16\xb5\xb5\x39\xa6\x3d\xa1\x89\x8b\x6f\xe5\xed\xc5\xc5\x1b\x9a\xf7\xec\x2a\xfa\x86\x71\x58\x9e\x66\x85\x84\x86\x6a\x51\x50\xc1\x59\x3d\x28\x77\xbb\x5b\xbb\xed\x8e\x96\xbb\xce\xed\xe4\xfd\xd8\x7e\xdf\xe0\x8b\x43\x4e\xae\xb6\x17\xb9\x94\x80\x5a\xd8\xf9\xd1\x10\x84\x82\x92\xe7\x3b\xed\xeb\xf7\xc8\x40\xcb\xec\x25\x6a\x76\x96\x2d\x24\xb7\xab\x03\xd8\x2f\xcd\x66\x28\x0a\x90\x80\xd2\x57\xd7\xd4\xf3\x87\xb9\x14\x64\x09\x9c\x2c\xcb\x0c\x76\x44\x04\x62\x65\xdc\x0e\xa3\xbe\x03\x70\x0d\x30\x87\xd0\x15\xef\x72\xec\x80\x71\x7d\x47\x53\x35\x18\x44\x79\xb6\x5a\xda\x02\xb9\x0e\x97\xd3\x2e\xea\xd9\x74\x16\xa7\xa5\x53\x2e\xed\xb0\x80\x27\x4b\x38\x79\x66\x54\x48\xcc\x7e\x0a\x93\xc4\x6c\xf6\x44\xfc\x02\x13\x44\x36\xb0\x1a\x9e\xc0\x41\x62\x09\x99\xe9\x99\x21\x62\x4e\x4b\xe8\x12\x81\x0e\x28\x4d\x5e\x76\x59\x73\x66\x24\x62\x5f\x1d\x61\xef\x8b\x20\x1a\x01\x7b\xf0\xc3\x90\xa0\xbb\x6f\xa3\xda\x6d\xd3\xa7\x30\x2e\xcf\x51\x2b\xe5\xad\xcf\x2a\x37\x8d\xbb\xac\xf1\xec\xb3\x74\x21\xef\xb8\xa3\x54\xc6\x20\xc1\xcc\x98\x8d\x69\xde\xdd\x6e\x6e\x17\x02\xea\xa7\x78\xf2\x35\x8c\x0a\x19\x8d\x59\x2d\xb4\x58\x0e\x88\x91\xc4\xe8\x76\x45\xbf\x90\x62\x86\x9d\x89\x89\xc3\x6d\x14\x9c\x2e\x16\xde\x6f\xc4\x37\xca\x38\x99\x7b\x2b\x6f\x64\x04\x51\x0a\x8d\x84\xb5\x69\xa7\x2d\xea\x82\x6a\xb0\x90\x97\x12\xdd\x1c\x3c\x87\x8e\x95\x30\x57\x29\xfc\x41\xc1\x2d\x7d\xbc\x50\xd1\xaa\xc1\x88\x67\xc4\x71\x14\x78\xf1\x3e\x1e\xc1\x5c\x8e\xc5\x53\x72\xa7\x23\x4c\xeb\x64\xb7\x05\xf2\x92\x50\xc1\xfb\xa0\xf7\x0a\x26\xda\xf0\x1f\xde\x36\x34\xe1\x36\x94\xbf\xc0\xcc\x3a\x2d\x3d\x63\x87\xca\x62\xc5\x60\x43\x2e\x36\x9e\x74\x7c\xa8\x53\x1d\x7c\x83\x69\xe7\xf9\x3d\x7b\x51\xfd\xea\xec\x67\xe1\x51\x37\x88\xd6\x6f\x03\xdc\x2d\xe1\xcf\xf0\x8e\x9a\x7e\x57\x53\xa5\x28\x7f\xfc\x38\x9d\xfd\x1f\xed\x0f\xb7\x2f\xfa\x71\x52\x30\x79\x0d\x4e\x89\xa7\xee\x91\x52\x3b\x97\xf7\xe2\x81\xd5\x08\x3d\xcb\x0b\x4c\xb9\xff\x67\x04\xdd\x07\x42\x77\x61\x28\x55\x6b\x51\x86\x39\x29\xf2\xf8\x89\xfa\xfd\xd4\x72\xd1\x43\x8f\x7a\xed\xc4\x64\x03\x06\x8a\xb4\x95\x72\xa8\xea\x1e\x49\xff\x70\x1c\xa8\x4a\x1e\x87\x4f\xec\xb0\x2d\xd0\x3a\x2e\x1a\x60\x24\x51\x23\xe2\x86\xbb\xa7\x66\xd3\x06\x67\x7c\x00\xd6\x78\x46\x1f\x35\x3c\x23\x89\x53\x62\x38\x01\x7e\x33\xb5\x6a\x9e\x2d\xd7\x9e\x02\x22\x3b\xa9\x18\x28\xc0\x62\x4a\x82\x3c\x63\xff\x13\x82\x36\xa0\x85\x1f\x8f\x1e\x19\x48\xb3\xed\x6d\x37\x46\x19\xdd\x00\x8f\x8e\x89\x99\xef\x62\xdd\xc6\xb1\xda\x77\xf7\x0d\x8b\x4f\xed\x00\x85\x84\x1b\xae\x6f\xbf\x0d\x1c\x7a\xfa\xe6\xff\x21\xe2\x3e\x10\xf1\xf3\x8a\xfb\x3a\x2e\x0a\xb9\x78\xbd\x8f\x29\xf5\x25\xaf\xdc\xd6\xc1\x69\x65\x3a\xba\x4f\xf3\xb0\xa1\x5b\xd0\xf6\x97\x86\x73\x7e\x69\x00\xd8\x30\xfd\xa8\x3d\xe3\x89\x43\x6e\x31\x54\x0d\x9a\x87\x13\x5d\x04\x8e\xc1\xa6\x51\xb5\x63\xa4\xbf\xef\x10\x6a\x33\x1b\xb4\xaf\xf4\x76\x1f\xdb\x42\x52\xb8\x6e\x99\xf9\xf8\x88\xf3\xb8\x0e\xe5\x8d\xc2\xe2\x95\x54\x16\x1a\x60\xe8\xa3\x0e\x30\x0e\xcb\xee\xa6\xb1\xae\xaf\x71\xba\xee\x93\x1c\x19\x7e\xd3\xb3\xba\xfb\x71\x68\x74\x9b\xc4\x4e\xdb\xb7\x49\x32\xba\x9b\x26\x09\xd1\xc1\x5d\xec\xbc\x6a\xf5\xbd\x36\x59\x8b\xe9\x6c\x5f\x53\xd4\xed\xbe\x0e\xc4\x2c\x86\xac\xe3\xbe\x3b\x57\x24\x51\x0c\xbb\x8f\xca\xa8\xbf\xba\xc8\x9b\xb4\x1f\xff\xd5\xcd\x28\x64\xea\x62\x95\x87\x65\x9c\xa5\x46\x1c\xa2\xed\x73\x35\x88\x85\x6b\xc1\x84\x6a\x89\x8f\xea\x15\x23\x87\xf3\xdd\xc4\x08\xbc\xc0\x5d\xf4\x1f\x40\x7a\xef\xbb\x77\x56\xac\x11\x60\xce\x1d\x2d\x85\xe7\xde\x8d\x36\x7a\x13\x8e\x84\xcd\x62\xc3\x4f\x81\x0f\xbd\x79\x5a\x88\xaa\x99\x8a\x8a\x32\x97\x9a\xaa\x8d\x77\x53\x05\x93\xac\x2e\x33\x74\x60\x62\x2b\x41\x98\xc2\x26\x08\x3e\x7c\xa9\x84\xa0\xe6\x7b\xef\xd0\x93\x3d\x13\x36\x0b\x1f\xcf\x6e\x21\xa2\x13\x7c\x4b\xd9\x15\x6b\x2b\x8f\x93\xb1\x76\x07\x65\x5e\x33\x9d\x87\xc6\x97\x79\x76\xad\xee\x7a\xda\xee\x7f\xfc\xda\x6b\x36\x3a\x02\x6c\xa4\xff\x2e\x50\xde\x05\xfc\x48\xd7\xfa\x3e\x87\x7b\x3d\x03\xb2\x5b\x91\xca\x76\xf8\xac\xdd\x13\x02\x55\xe0\xd5\x5e\xcd\x41\xef\x7f\x01\x00\x00\xff\xff\x03\x00\xf7\xc8\x0a\x09\x9f\x23\x00\x00")
 
 func templatesLoaders_bodyGohtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -85,12 +85,12 @@ func templatesLoaders_bodyGohtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/loaders_body.gohtml", size: 1372, mode: os.FileMode(420), modTime: time.Unix(1558529830, 0)}
+	info := bindataFileInfo{name: "templates/loaders_body.gohtml", size: 9119, mode: os.FileMode(420), modTime: time.Unix(1792410406, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templatesLoaders_headGohtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x44\x8c\xc1\x4a\x04\x31\x0c\x86\xef\x79\x8a\x30\xec\x41\x17\x66\x0a\x1e\x17\x3c\x88\x88\x0a\x1e\x3c\xe8\x03\xc4\x69\xc8\x96\xed\xb6\xb5\xcd\x82\x12\xfa\xee\x42\x77\xc0\x53\xc2\xff\x7d\xff\x6f\x36\xa3\xdb\x4b\xd6\xdf\xc2\x07\x94\xa0\xc7\xcb\xd7\xb2\xe6\xb3\x7b\x7a\xfe\x98\x3f\x4f\x95\x42\x62\x27\xf9\x4e\xbe\xa3\x13\x4e\x5c\x49\x73\x75\x25\x5e\x24\xa4\xe6\x3c\x29\xc5\x4c\x9e\xeb\xf2\x36\x4e\x7b\x61\xf2\x8f\x39\x29\xff\xe8\xde\xe1\xdc\x3b\x40\xa1\xf5\x44\xc2\x78\x15\x1b\x40\x38\x97\x5c\x15\x6f\x60\x5a\xaf\xe6\x04\x60\x56\x29\x09\xe3\x6e\x83\x87\x7b\xdc\x2d\xaf\xe3\x6f\x63\x06\x11\xd1\x6c\xc3\xcb\x43\x0c\xd4\x7a\xc7\xe9\x3f\x7a\x27\x3d\xf6\x3e\x81\x19\x27\x3f\x2a\xb7\xf0\x17\x00\x00\xff\xff\x95\x1a\xd0\x59\xde\x00\x00\x00")
+var _templatesLoaders_headGohtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x8e\xc1\x6a\xc3\x30\x0c\x86\xef\x7e\x0a\x11\x7a\xd8\x0a\xb1\x60\xc7\xc2\x0e\x63\x2d\x5b\x60\xac\x3b\x78\x0f\xa0\xc6\xaa\x63\xea\xda\x99\xed\xb2\x15\xe3\x77\x5f\x48\x03\xbb\x4d\x17\x89\xff\x97\xfe\x4f\xa5\xb4\x80\x6b\x13\xf2\x75\xe4\x0d\x18\x9b\x87\xcb\x41\xf6\xe1\x8c\xbb\x17\xd5\x7e\x9e\x22\x59\xcf\x68\xc2\x83\xf9\x72\x68\xd8\x73\xa4\x1c\x22\x8e\xee\x62\xac\x4f\xa8\x29\x93\x0b\xa4\x39\xca\xb7\xb9\xa5\x57\x26\xfd\x1c\x7c\xe6\x9f\xbc\x46\x68\x6b\x15\x88\xa0\x06\x9b\xe0\x68\x1d\xc3\x37\x25\x58\x62\x58\xc3\xe1\xfa\x3f\x51\xc2\x76\x0f\xef\x7b\x05\xbb\x6d\xa7\xa0\x53\x62\xa4\xfe\x44\x86\xe1\xc6\x4c\x42\xd8\xf3\x18\x62\x86\x3b\xd1\xf4\x37\x68\x23\x44\x29\x91\xfc\xb4\xb4\x5a\xcc\xcd\x23\xac\x64\x37\xcf\x69\xfe\x08\xa6\x2a\x65\xb1\xe5\x93\xb3\x94\x6a\x85\xe6\x4f\xfa\xa0\x3c\xd4\xda\x4c\x49\xec\xf5\x7c\x72\x2f\x7e\x01\x00\x00\xff\xff\x03\x00\x39\xcc\x6f\xec\x29\x01\x00\x00")
 
 func templatesLoaders_headGohtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -105,12 +105,12 @@ func templatesLoaders_headGohtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/loaders_head.gohtml", size: 222, mode: os.FileMode(420), modTime: time.Unix(1547632212, 0)}
+	info := bindataFileInfo{name: "templates/loaders_head.gohtml", size: 297, mode: os.FileMode(420), modTime: time.Unix(1792403763, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templatesOutput_object_fieldsGohtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x54\xcb\x6e\xdb\x30\x10\x3c\xd3\x5f\xc1\x1a\x41\x21\x05\x09\x05\xf4\x68\x20\x87\x20\xaf\x43\x8b\xc4\x48\xdc\xf6\x58\xd0\xd2\x8a\x66\x4d\x91\x32\x45\xa5\x4d\x09\xfe\x7b\xb1\x24\x65\x1b\x2e\xd0\x4b\x81\x9e\x2c\x73\x77\x76\x66\x67\x24\x7a\x7f\x49\xab\x73\x61\xdc\x5b\x0f\x0b\x2a\xa4\xdb\x8c\x6b\x56\x9b\xae\xba\x7b\x58\x5d\x7e\xde\x5a\x2e\x35\x54\xc2\x7c\x10\x3b\x55\x09\xd0\x60\xb9\x33\xb6\xea\xd5\x28\xa4\x1e\x2a\x61\x79\xbf\xd9\x29\xf6\x0c\xba\x01\x7b\x2f\x41\x35\xc3\x8d\xd1\x0e\x7e\xba\xf3\x8a\x5e\x86\x30\xf3\x9e\x5a\xae\x05\xd0\xb3\x16\xab\x74\x71\x45\xcf\xd8\xd3\xe8\xfa\xd1\x3d\xad\xbf\x43\xed\xd8\x2d\x77\xfc\x93\xe1\x7b\x7c\x84\x11\xef\x4f\xda\xbe\x70\x2b\xf9\x5a\xc1\x23\xef\x20\x04\x76\xdd\x34\xb1\xfd\xc6\xe8\x56\x8a\x62\xee\x7d\x22\x60\xa9\x3e\xbf\xa0\xef\xbd\x17\x3b\xb5\xdc\x8a\x10\x58\x6c\xf5\x33\x42\xb0\xba\x20\xe4\xcf\xf6\x19\x21\xb7\x30\xd4\x56\xf6\x4e\x1a\xbd\xa0\xf3\x78\xb4\x42\x57\x08\xf1\x3e\x2f\x9a\x14\x25\xb5\x58\x43\x34\xee\x13\x25\xe6\xc5\xf3\xa6\x21\xe0\x80\x67\x18\x8c\x7a\x85\x05\x6d\x47\x5d\x17\x3d\x3d\x18\x16\xcf\x97\xdc\xf2\x6e\x28\x69\x21\xb5\x03\xdb\xf2\x1a\x7c\xb8\xa0\x60\xad\xb1\x25\x45\xbd\xa4\xe7\x16\xb4\x43\xdb\x7a\xf6\x62\x46\x5b\x03\x2b\xce\xbd\x17\x06\xe9\x4f\xad\x7c\x88\xa7\x21\x94\x33\x84\xaa\x28\x73\x40\xac\xf7\xf9\x4f\xb2\xe3\x01\xdc\xc1\xf5\xe1\xde\x9a\x2e\x6b\x2f\x7a\x96\x9f\xd2\x08\xd9\xd2\x69\xca\xd5\x15\xd5\x52\x25\x51\xc4\x82\x1b\xad\xc6\x83\xac\x76\x60\x8f\xf0\xa3\x98\xe3\xd4\x3d\x42\x1b\x47\x5b\x33\xea\x86\x4a\x4d\xeb\x34\x96\xd1\x1b\xae\xd4\xd4\x82\x42\x32\xdf\x57\xe9\x36\x59\xcf\xbc\x44\x8e\x10\x05\x78\x2f\xdb\x6c\x28\xfb\x08\x6f\x31\xc7\x17\x25\x6b\xc0\x77\x84\x10\xb7\x19\xf5\x16\x17\x9c\x06\xee\x73\x3d\xec\x97\x12\x4e\xcf\x0c\x7f\xae\x95\x5a\x21\xae\x48\xde\x1e\x30\x8f\xc6\x76\x5c\xc9\x5f\xd0\x2c\x63\x65\x22\x4c\x13\x92\x23\x79\xf3\x18\xe7\xdf\x72\x23\xaf\xdc\x66\x55\x77\xd1\xa0\x54\x9d\x65\xfb\x86\x51\xb9\x08\x88\xf1\xc4\x35\x8a\x44\x40\x5a\x63\xe9\xb7\x58\xc3\x52\xfa\x78\x62\x63\x9a\x8b\x99\x60\xed\xdd\x71\x1e\x53\xd8\x99\x0a\x03\xef\x46\xe5\x64\xe4\x4c\x99\x5f\xf7\x3d\xe8\xa6\x38\xee\x8b\x24\x65\x1a\x10\xed\xcc\xa6\x4f\x4b\x4e\x32\x8f\x31\x31\x9a\x0b\xa4\x4e\xf1\x80\x1a\xfe\x25\x8b\xff\x10\x44\x6e\x9c\x3c\x3e\xd5\xaf\x9b\x28\x1f\xbf\xd6\x50\xe2\x75\x05\xba\xc1\x2b\xe8\x77\x00\x00\x00\xff\xff\x45\xf6\x7b\xaf\x19\x05\x00\x00")
+var _templatesOutput_object_fieldsGohtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x54\x4b\x4f\xdc\x30\x10\x3e\x87\x5f\xe1\x46\xa8\xca\xa2\xc5\x91\x7a\x5c\x69\x0f\x2b\x5e\x87\x56\x80\x80\xb6\xc7\xca\x64\x27\xc1\x5d\xaf\x1d\x1c\x07\x8a\xac\xfc\xf7\xce\xd8\xce\x66\xb5\x15\x95\xda\x5b\xec\x79\x7c\x8f\x99\xd8\xfb\x53\x56\x9e\x34\xc6\xbd\xb5\xb0\x60\x8d\x74\x4f\xfd\x23\xaf\xcc\xb6\xbc\xb8\x7a\x38\xfd\xba\xb1\x42\x6a\x28\x1b\xf3\xa9\x79\x56\x65\x03\x1a\xac\x70\xc6\x96\xad\xea\x1b\xa9\xbb\xb2\xb1\xa2\x7d\x7a\x56\xfc\x0e\xf4\x1a\xec\xa5\x04\xb5\xee\xce\x8c\x76\xf0\xcb\x9d\x94\xec\x74\x18\x8e\xbc\x67\x56\xe8\x06\xd8\x71\x4d\x51\xb6\x58\xb2\x63\x7e\xd3\xbb\xb6\x77\x37\x8f\x3f\xa1\x72\xfc\x5c\x38\xf1\xc5\x88\x5d\x7d\x28\xcb\xbc\x3f\x48\xfb\x26\xac\x14\x8f\x0a\xae\xc5\x16\x86\x81\xaf\xd6\xeb\x90\x8e\x68\xb5\x6c\x8a\x1c\xf3\x03\x00\x8f\xf1\x7c\xce\x3e\x7a\x8f\xa4\x6f\x37\x0d\x66\x87\x54\x7f\x94\x65\x14\x5d\x64\xd9\x9f\xe9\x18\x3b\x87\xae\xb2\xb2\x75\xd2\xe8\x05\xcb\xc3\xd5\x03\xb9\x92\x21\x99\x24\x34\x32\x8a\x6c\x29\x46\xd5\xa4\x27\x50\x4c\xc2\x93\xd2\x61\xa0\x06\x1e\xed\x7d\x45\x53\x99\x9a\x14\xae\x6c\xd3\xed\x92\x30\x87\xce\x0b\xe6\x3d\x9f\x4a\xd0\xce\x10\xba\x83\xce\xa8\x17\x1c\x4c\xdd\xeb\xaa\x68\xd9\xe4\x77\xb8\xbf\x15\x56\x6c\xbb\x19\x2b\x24\x22\xdb\x5a\x54\xe0\x87\x39\x03\x6b\x8d\x9d\x31\x92\x9b\xb5\xc2\x82\x76\xe4\x7a\xcb\xef\x4d\x6f\x2b\xe0\xc5\x09\xca\x31\xc4\xfe\x70\x12\x57\xe1\x76\x18\x66\x54\xe9\x7d\xa4\xbc\xc7\x96\xe5\x02\x0f\x39\xcb\x5b\xbe\x0a\x1f\x61\x52\x94\x2a\xeb\x24\xf0\x33\xbc\x21\xa9\x0e\xd6\x63\x49\x4a\x79\x11\x96\x6d\xe0\xed\xc2\xda\x48\x8f\xee\xf0\x4c\xc4\x46\x20\x2c\xdd\xe1\x44\xda\x79\x02\x8c\x2d\x10\x23\x75\xf8\xb0\x64\x5a\xaa\x28\x30\xb3\xe0\x7a\xab\xe9\x62\x9e\xe2\x74\x9d\x78\xa1\x93\xaa\x83\x91\xc4\x3f\x02\x4e\x83\xa0\x53\xac\xe9\xf6\x1b\x74\x71\xb9\xae\xc0\x4d\x3b\xdc\x5d\x5a\xb3\x4d\x9b\x50\xb4\x3c\x7d\xcd\x8e\x92\x82\xb1\xcb\xf2\x3d\x09\xc1\x9d\x8e\x5f\xc3\x6b\x91\x53\xd7\x5d\x85\x36\x8e\xd5\xa6\xd7\x6b\x26\x35\xab\x62\x5b\xce\xce\x84\x52\x63\x0a\x11\x49\x78\xdf\x71\xe5\x12\x9f\x7c\x16\xfd\x98\x34\x90\x84\xbd\x92\xdd\xaf\x30\x89\x88\x3f\x45\xfc\x2e\x22\xf7\x30\xe3\x94\x88\xc6\x85\x45\xbe\x57\xb2\x82\xe8\x15\x3e\x1b\x7a\x33\x35\xe6\x54\xbb\x52\xea\x81\xae\x0b\xf4\x3d\x36\x49\x42\xc3\x32\xff\x6d\x6b\xc3\xba\xc4\x4e\x17\xc1\x8f\xb4\x34\xc9\xad\xae\x57\x2e\x14\x84\x69\x04\xe8\xc4\x32\xab\x8d\x65\x3f\x42\x8c\x42\xf1\xe5\x09\x89\xb1\x2f\x8d\x00\x0e\x37\x68\xf4\x25\x41\xd1\x7c\xb7\x88\x20\x03\x66\x1c\xf1\xaa\x6d\x71\x13\x8a\xfd\xbc\x00\x32\x8b\x0d\x82\x05\xc9\xe3\x51\xe4\x48\x73\xbf\x26\x4c\x62\x4e\xd0\xd1\x52\x5a\xce\xf7\xfd\xfb\x5f\xf3\x52\xe2\xe8\xcb\x21\x66\x7a\x5a\xe8\xad\xc1\x3f\x1d\xdf\x67\xbc\xa1\x3f\xe4\x37\x00\x00\x00\xff\xff\x03\x00\x1c\x06\x08\xdd\x0a\x06\x00\x00")
 
 func templatesOutput_object_fieldsGohtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -125,7 +125,7 @@ func templatesOutput_object_fieldsGohtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/output_object_fields.gohtml", size: 1305, mode: os.FileMode(420), modTime: time.Unix(1548935420, 0)}
+	info := bindataFileInfo{name: "templates/output_object_fields.gohtml", size: 1546, mode: os.FileMode(420), modTime: time.Unix(1792410575, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -0,0 +1,164 @@
+package external
+
+import (
+	"github.com/EGT-Ukraine/go2gql/generator/plugins/graphql"
+)
+
+func goType(typ graphql.GoType) GoType {
+	res := GoType{
+		Kind:   typ.Kind.String(),
+		Scalar: typ.Scalar,
+		Name:   typ.Name,
+		Pkg:    typ.Pkg,
+	}
+	if typ.Kind == graphql.KindBytes {
+		res.Kind = "bytes"
+	}
+	if typ.ElemType != nil {
+		elem := goType(*typ.ElemType)
+		res.Elem = &elem
+	}
+	if typ.Elem2Type != nil {
+		elem2 := goType(*typ.Elem2Type)
+		res.Elem2 = &elem2
+	}
+
+	return res
+}
+
+func fields(fields []graphql.ObjectField, ctx graphql.BodyContext) []Field {
+	res := make([]Field, len(fields))
+	for i, field := range fields {
+		res[i] = Field{
+			Name:        field.Name,
+			GraphQLType: field.Type(ctx),
+			GoType:      goType(field.GoType),
+		}
+	}
+
+	return res
+}
+
+func outputObject(object graphql.OutputObject, ctx graphql.BodyContext) OutputObject {
+	res := OutputObject{
+		VariableName: object.VariableName,
+		GraphQLName:  object.GraphQLName,
+		GoType:       goType(object.GoType),
+		Fields:       fields(object.Fields, ctx),
+		MapFields:    fields(object.MapFields, ctx),
+	}
+	for _, field := range object.DataLoaderFields {
+		res.DataLoaderFields = append(res.DataLoaderFields, DataLoaderField{
+			Name:           field.Name,
+			DataLoaderName: field.DataLoaderName,
+		})
+	}
+
+	return res
+}
+
+func methods(methods []graphql.Method, ctx graphql.BodyContext) []Method {
+	res := make([]Method, len(methods))
+	for i, method := range methods {
+		res[i] = Method{
+			Name:         method.Name,
+			OriginalName: method.OriginalName,
+			GraphQLType:  method.GraphQLOutputType(ctx),
+			RequestType:  goType(method.RequestType),
+		}
+		for _, arg := range method.Arguments {
+			res[i].Arguments = append(res[i].Arguments, MethodArgument{
+				Name:        arg.Name,
+				GraphQLType: arg.Type(ctx),
+			})
+		}
+	}
+
+	return res
+}
+
+func typesFile(outputPath string, file *graphql.TypesFile, ctx graphql.BodyContext) TypesFile {
+	res := TypesFile{
+		OutputPath:  outputPath,
+		PackageName: file.PackageName,
+		Package:     file.Package,
+	}
+	for _, enum := range file.Enums {
+		e := Enum{
+			VariableName: enum.VariableName,
+			GraphQLName:  enum.GraphQLName,
+		}
+		for _, value := range enum.Values {
+			e.Values = append(e.Values, EnumValue{
+				Name:  value.Name,
+				Value: value.Value,
+			})
+		}
+		res.Enums = append(res.Enums, e)
+	}
+	for _, object := range file.OutputObjects {
+		res.OutputObjects = append(res.OutputObjects, outputObject(object, ctx))
+	}
+	for _, object := range file.InputObjects {
+		res.InputObjects = append(res.InputObjects, InputObject{
+			VariableName: object.VariableName,
+			GraphQLName:  object.GraphQLName,
+			Fields:       fields(object.Fields, ctx),
+		})
+	}
+	for _, service := range file.Services {
+		res.Services = append(res.Services, Service{
+			Name:            service.Name,
+			OriginalName:    service.OriginalName,
+			CallInterface:   goType(service.CallInterface),
+			QueryMethods:    methods(service.QueryMethods, ctx),
+			MutationMethods: methods(service.MutationMethods, ctx),
+		})
+	}
+	// imports are filled after all types are resolved.
+	for _, imp := range ctx.Importer.Imports() {
+		res.Imports = append(res.Imports, Import{
+			Alias: imp.Alias,
+			Path:  imp.Path,
+		})
+	}
+
+	return res
+}
+
+func schema(schemaObjects graphql.SchemaObjects) Schema {
+	res := Schema{
+		Name:           schemaObjects.SchemaName,
+		Package:        schemaObjects.GoPkg,
+		QueryObject:    schemaObjects.QueryObject,
+		MutationObject: schemaObjects.MutationObject,
+	}
+	for _, service := range schemaObjects.Services {
+		res.Services = append(res.Services, SchemaService{
+			Name:    service.Name,
+			Package: service.Pkg,
+			Fields:  service.Fields,
+		})
+	}
+	for _, object := range schemaObjects.Objects {
+		o := SchemaObject{
+			Name:  object.Name,
+			Query: object.QueryObject,
+		}
+		for _, field := range object.Fields {
+			f := SchemaObjectField{
+				Name: field.Name,
+			}
+			if field.Service != nil {
+				f.Service = field.Service.Name
+			}
+			if field.Object != nil {
+				f.Object = field.Object.Name
+			}
+			o.Fields = append(o.Fields, f)
+		}
+		res.Objects = append(res.Objects, o)
+	}
+
+	return res
+}
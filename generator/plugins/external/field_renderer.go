@@ -0,0 +1,55 @@
+package external
+
+import (
+	"bytes"
+	"text/template"
+
+	"github.com/pkg/errors"
+
+	"github.com/EGT-Ukraine/go2gql/generator/plugins/graphql"
+)
+
+// fieldRenderer renders external plugin template into init function of output object.
+type fieldRenderer struct {
+	object   string
+	template string
+}
+
+func newFieldRenderer(cfg FieldRenderer) (*fieldRenderer, error) {
+	// check template syntax before generation.
+	_, err := template.New("fieldRenderer").Funcs(fieldRendererFuncs(nil)).Parse(cfg.Template)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse template")
+	}
+
+	return &fieldRenderer{
+		object:   cfg.Object,
+		template: cfg.Template,
+	}, nil
+}
+
+func fieldRendererFuncs(ctx *graphql.BodyContext) map[string]interface{} {
+	return map[string]interface{}{
+		"import": func(path string) string {
+			return ctx.Importer.New(path)
+		},
+	}
+}
+
+func (r *fieldRenderer) RenderFields(o graphql.OutputObject, ctx graphql.BodyContext) (string, error) {
+	if o.GraphQLName != r.object {
+		return "", nil
+	}
+
+	tpl, err := template.New("fieldRenderer").Funcs(fieldRendererFuncs(&ctx)).Parse(r.template)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to parse template")
+	}
+
+	buf := new(bytes.Buffer)
+	if err := tpl.Execute(buf, outputObject(o, ctx)); err != nil {
+		return "", errors.Wrap(err, "failed to execute template")
+	}
+
+	return buf.String(), nil
+}
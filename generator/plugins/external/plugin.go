@@ -0,0 +1,245 @@
+package external
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+
+	"github.com/pkg/errors"
+	"golang.org/x/tools/imports"
+
+	"github.com/EGT-Ukraine/go2gql/generator"
+	"github.com/EGT-Ukraine/go2gql/generator/plugins/graphql"
+	"github.com/EGT-Ukraine/go2gql/generator/plugins/graphql/lib/importer"
+)
+
+const (
+	PluginName               = "external"
+	ExternalPluginsConfigKey = "external_plugins"
+)
+
+type PluginConfig struct {
+	Name    string      `mapstructure:"name"`
+	Command string      `mapstructure:"command"`
+	Args    []string    `mapstructure:"args"`
+	Config  interface{} `mapstructure:"config"` // passed to command as is
+}
+
+// Plugin runs executable plugins. Executable gets Request on stdin and writes Response to stdout.
+type Plugin struct {
+	gqlPlugin     *graphql.Plugin
+	generateCfg   *generator.GenerateConfig
+	pluginsConfig []PluginConfig
+}
+
+func (p *Plugin) Init(config *generator.GenerateConfig, plugins []generator.Plugin) error {
+	p.generateCfg = config
+
+	for _, plugin := range plugins {
+		if g, ok := plugin.(*graphql.Plugin); ok {
+			p.gqlPlugin = g
+
+			break
+		}
+	}
+
+	if p.gqlPlugin == nil {
+		return errors.New("graphql plugin was not found")
+	}
+
+	if err := config.DecodePluginConfig(ExternalPluginsConfigKey, &p.pluginsConfig); err != nil {
+		return errors.Wrap(err, "failed to decode external plugins config")
+	}
+
+	for i, cfg := range p.pluginsConfig {
+		if cfg.Name == "" {
+			return errors.Errorf("external plugin #%d name is not specified", i)
+		}
+		if cfg.Command == "" {
+			return errors.Errorf("external plugin %s command is not specified", cfg.Name)
+		}
+	}
+
+	return nil
+}
+
+func (p *Plugin) Prepare() error {
+	return nil
+}
+
+func (p Plugin) Name() string {
+	return PluginName
+}
+
+// Dependents returns graphql plugin, because external plugins add types files and field renderers to it.
+func (p *Plugin) Dependents() []string {
+	return []string{graphql.PluginName}
+}
+
+func (p *Plugin) PrintInfo(info generator.Infos) {
+}
+
+func (p *Plugin) Infos() map[string]string {
+	return nil
+}
+
+func (p *Plugin) Generate() error {
+	if len(p.pluginsConfig) == 0 {
+		return nil
+	}
+
+	req, err := p.request()
+	if err != nil {
+		return errors.Wrap(err, "failed to prepare external plugins request")
+	}
+
+	for _, cfg := range p.pluginsConfig {
+		req.PluginName = cfg.Name
+		req.Config = jsonCompatible(cfg.Config)
+
+		res, err := p.run(cfg, req)
+		if err != nil {
+			return errors.Wrapf(err, "failed to run external plugin %s", cfg.Name)
+		}
+
+		if err := p.apply(cfg, res); err != nil {
+			return errors.Wrapf(err, "failed to apply external plugin %s response", cfg.Name)
+		}
+	}
+
+	return nil
+}
+
+func (p *Plugin) request() (Request, error) {
+	req := Request{
+		ProtocolVersion: ProtocolVersion,
+		VendorPath:      p.generateCfg.VendorPath,
+	}
+
+	types := p.gqlPlugin.Types()
+	for _, outputPath := range graphql.TypesFilesPaths(types) {
+		file := types[outputPath]
+		ctx := graphql.BodyContext{
+			File:          file,
+			Importer:      &importer.Importer{CurrentPackage: file.Package},
+			TracerEnabled: p.generateCfg.GenerateTraces,
+		}
+		req.TypesFiles = append(req.TypesFiles, typesFile(outputPath, file, ctx))
+	}
+
+	schemas, err := p.gqlPlugin.SchemasObjects()
+	if err != nil {
+		return req, errors.Wrap(err, "failed to resolve schemas objects")
+	}
+	for _, schemaObjects := range schemas {
+		req.Schemas = append(req.Schemas, schema(schemaObjects))
+	}
+
+	return req, nil
+}
+
+func (p *Plugin) run(cfg PluginConfig, req Request) (*Response, error) {
+	in, err := json.Marshal(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal request")
+	}
+
+	out := new(bytes.Buffer)
+	cmd := exec.Command(os.ExpandEnv(cfg.Command), cfg.Args...) //nolint:gosec
+	cmd.Stdin = bytes.NewReader(in)
+	cmd.Stdout = out
+	cmd.Stderr = os.Stderr
+
+	if err := cmd.Run(); err != nil {
+		return nil, errors.Wrapf(err, "command %s failed", cfg.Command)
+	}
+
+	res := new(Response)
+	if err := json.Unmarshal(out.Bytes(), res); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal response")
+	}
+
+	if res.Error != "" {
+		return nil, errors.New(res.Error)
+	}
+
+	return res, nil
+}
+
+func (p *Plugin) apply(cfg PluginConfig, res *Response) error {
+	for _, file := range res.Files {
+		err := p.generateCfg.Output.WriteFile(generator.File{
+			Path:    file.Path,
+			Plugin:  cfg.Name,
+			Content: []byte(file.Content),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, file := range res.TypesFiles {
+		src, err := goFileSource(file)
+		if err != nil {
+			return errors.Wrapf(err, "failed to format go file %s", file.OutputPath)
+		}
+		err = p.generateCfg.Output.WriteFile(generator.File{
+			Path:    file.OutputPath,
+			Plugin:  cfg.Name,
+			Content: src,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, renderer := range res.FieldRenderers {
+		r, err := newFieldRenderer(renderer)
+		if err != nil {
+			return errors.Wrapf(err, "invalid %s object field renderer", renderer.Object)
+		}
+		p.gqlPlugin.AddOutputObjectFieldRenderer(r)
+	}
+
+	return nil
+}
+
+func goFileSource(file GoFile) ([]byte, error) {
+	buf := new(bytes.Buffer)
+	buf.WriteString("// This file was generated by github.com/EGT-Ukraine/go2gql external plugin. DO NOT EDIT IT\n")
+	buf.WriteString("package " + file.PackageName + "\n\n")
+	if len(file.Imports) > 0 {
+		buf.WriteString("import (\n")
+		for _, imp := range file.Imports {
+			buf.WriteString("\t" + imp.Alias + " \"" + imp.Path + "\"\n")
+		}
+		buf.WriteString(")\n\n")
+	}
+	buf.WriteString(file.Body)
+
+	return imports.Process(file.OutputPath, buf.Bytes(), nil)
+}
+
+// jsonCompatible converts maps, decoded from yaml, to maps with string keys, which can be marshaled to JSON.
+func jsonCompatible(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[interface{}]interface{}:
+		res := make(map[string]interface{}, len(v))
+		for key, val := range v {
+			res[fmt.Sprint(key)] = jsonCompatible(val)
+		}
+
+		return res
+	case []interface{}:
+		res := make([]interface{}, len(v))
+		for i, val := range v {
+			res[i] = jsonCompatible(val)
+		}
+
+		return res
+	}
+
+	return value
+}
@@ -0,0 +1,116 @@
+package external
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/EGT-Ukraine/go2gql/generator"
+	"github.com/EGT-Ukraine/go2gql/generator/plugins/graphql"
+	"github.com/EGT-Ukraine/go2gql/generator/plugins/graphql/lib/importer"
+)
+
+// TestHelperProcess isn't real test. It's used as external plugin command.
+func TestHelperProcess(t *testing.T) {
+	if os.Getenv("GO2GQL_EXTERNAL_PLUGIN_HELPER") != "1" {
+		return
+	}
+
+	var req Request
+	if err := json.NewDecoder(os.Stdin).Decode(&req); err != nil {
+		os.Exit(2)
+	}
+	cfg := req.Config.(map[string]interface{})
+	if cfg["fail"] == true {
+		os.Exit(1)
+	}
+
+	object := req.TypesFiles[0].OutputObjects[0]
+	res := Response{
+		Files: []File{{
+			Path:    cfg["out"].(string),
+			Content: req.PluginName + ":" + object.GraphQLName + ":" + object.Fields[0].GraphQLType,
+		}},
+		FieldRenderers: []FieldRenderer{{
+			Object:   object.GraphQLName,
+			Template: `{{.VariableName}}.AddFieldConfig("ping", &{{import "github.com/graphql-go/graphql"}}.Field{})`,
+		}},
+	}
+	if err := json.NewEncoder(os.Stdout).Encode(res); err != nil {
+		os.Exit(2)
+	}
+	os.Exit(0)
+}
+
+func TestPlugin(t *testing.T) {
+	Convey("Test external plugin", t, func() {
+		dir, err := ioutil.TempDir("", "go2gql-external")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(dir)
+
+		So(os.Setenv("GO2GQL_EXTERNAL_PLUGIN_HELPER", "1"), ShouldBeNil)
+		defer os.Unsetenv("GO2GQL_EXTERNAL_PLUGIN_HELPER")
+
+		outPath := filepath.Join(dir, "out.txt")
+		pluginConfig := map[interface{}]interface{}{
+			"out": outPath,
+		}
+		cfg := &generator.GenerateConfig{
+			PluginsConfigs: generator.PluginsConfigs{
+				ExternalPluginsConfigKey: []interface{}{
+					map[interface{}]interface{}{
+						"name":    "helper",
+						"command": os.Args[0],
+						"args":    []interface{}{"-test.run=TestHelperProcess"},
+						"config":  pluginConfig,
+					},
+				},
+			},
+		}
+
+		gqlPlugin := new(graphql.Plugin)
+		So(gqlPlugin.Init(cfg, nil), ShouldBeNil)
+		object := graphql.OutputObject{
+			VariableName: "UserObject",
+			GraphQLName:  "User",
+			GoType:       graphql.GoType{Kind: reflect.Struct, Name: "User", Pkg: "example.com/users"},
+			Fields: []graphql.ObjectField{{
+				Name:   "name",
+				GoType: graphql.GoType{Scalar: true, Kind: reflect.String},
+				Type: func(ctx graphql.BodyContext) string {
+					return ctx.Importer.New(graphql.GraphqlPkgPath) + ".String"
+				},
+			}},
+		}
+		gqlPlugin.AddTypesFile(filepath.Join(dir, "types.go"), &graphql.TypesFile{
+			PackageName:   "schema",
+			Package:       "example.com/schema",
+			OutputObjects: []graphql.OutputObject{object},
+		})
+
+		plugin := new(Plugin)
+		So(plugin.Init(cfg, []generator.Plugin{gqlPlugin, plugin}), ShouldBeNil)
+
+		Convey("Should write command files", func() {
+			So(plugin.Generate(), ShouldBeNil)
+
+			content, err := ioutil.ReadFile(outPath)
+			So(err, ShouldBeNil)
+			So(string(content), ShouldEqual, "helper:User:graphql.String")
+
+			renderer := &fieldRenderer{object: "User", template: `{{.VariableName}}.AddFieldConfig("ping", &{{import "github.com/graphql-go/graphql"}}.Field{})`}
+			fields, err := renderer.RenderFields(object, graphql.BodyContext{Importer: &importer.Importer{}})
+			So(err, ShouldBeNil)
+			So(fields, ShouldEqual, `UserObject.AddFieldConfig("ping", &graphql.Field{})`)
+		})
+		Convey("Should return error of failed command", func() {
+			pluginConfig["fail"] = true
+			So(plugin.Generate(), ShouldNotBeNil)
+		})
+	})
+}
@@ -0,0 +1,160 @@
+package external
+
+// ProtocolVersion is version of external plugins protocol.
+// It's increased only on backward incompatible changes.
+const ProtocolVersion = 1
+
+// Request is written as JSON to external plugin command stdin.
+type Request struct {
+	ProtocolVersion int         `json:"protocol_version"`
+	PluginName      string      `json:"plugin_name"`
+	Config          interface{} `json:"config"` // plugin config from generate config
+	VendorPath      string      `json:"vendor_path"`
+	TypesFiles      []TypesFile `json:"types_files"`
+	Schemas         []Schema    `json:"schemas"`
+}
+
+// Response is read as JSON from external plugin command stdout.
+type Response struct {
+	Error          string          `json:"error,omitempty"`
+	TypesFiles     []GoFile        `json:"types_files,omitempty"`
+	FieldRenderers []FieldRenderer `json:"field_renderers,omitempty"`
+	Files          []File          `json:"files,omitempty"`
+}
+
+// GoFile is go source file, which imports are fixed and which is formatted before writing.
+type GoFile struct {
+	OutputPath  string   `json:"output_path"`
+	PackageName string   `json:"package_name"`
+	Imports     []Import `json:"imports,omitempty"`
+	Body        string   `json:"body"` // file body without package clause and imports
+}
+
+// FieldRenderer adds fields to generated output object.
+// Template is go text/template, which is executed with OutputObject.
+// Template function `import "<go package>"` returns package alias in generated file.
+type FieldRenderer struct {
+	Object   string `json:"object"` // output object GraphQL name
+	Template string `json:"template"`
+}
+
+// File is written to Path as is.
+type File struct {
+	Path    string `json:"path"`
+	Content string `json:"content"`
+}
+
+// GoType describes go type. Kind is reflect.Kind name or "bytes" for []byte.
+type GoType struct {
+	Kind   string  `json:"kind"`
+	Scalar bool    `json:"scalar,omitempty"`
+	Name   string  `json:"name,omitempty"`
+	Pkg    string  `json:"pkg,omitempty"`
+	Elem   *GoType `json:"elem,omitempty"`  // slice, pointer elem type or map key type
+	Elem2  *GoType `json:"elem2,omitempty"` // map value type
+}
+
+// Import of generated go file.
+type Import struct {
+	Alias string `json:"alias"`
+	Path  string `json:"path"`
+}
+
+// TypesFile describes generated GraphQL types file.
+// GraphQL types are go expressions, which are valid in file package with file Imports.
+type TypesFile struct {
+	OutputPath    string         `json:"output_path"`
+	PackageName   string         `json:"package_name"`
+	Package       string         `json:"package"`
+	Imports       []Import       `json:"imports"`
+	Enums         []Enum         `json:"enums"`
+	OutputObjects []OutputObject `json:"output_objects"`
+	InputObjects  []InputObject  `json:"input_objects"`
+	Services      []Service      `json:"services"`
+}
+
+type Enum struct {
+	VariableName string      `json:"variable_name"`
+	GraphQLName  string      `json:"graphql_name"`
+	Values       []EnumValue `json:"values"`
+}
+
+type EnumValue struct {
+	Name  string `json:"name"`
+	Value int    `json:"value"`
+}
+
+type OutputObject struct {
+	VariableName     string            `json:"variable_name"`
+	GraphQLName      string            `json:"graphql_name"`
+	GoType           GoType            `json:"go_type"`
+	Fields           []Field           `json:"fields"`
+	MapFields        []Field           `json:"map_fields"`
+	DataLoaderFields []DataLoaderField `json:"data_loader_fields"`
+}
+
+type InputObject struct {
+	VariableName string  `json:"variable_name"`
+	GraphQLName  string  `json:"graphql_name"`
+	Fields       []Field `json:"fields"`
+}
+
+type Field struct {
+	Name        string `json:"name"`
+	GraphQLType string `json:"graphql_type"`
+	GoType      GoType `json:"go_type"`
+}
+
+type DataLoaderField struct {
+	Name           string `json:"name"`
+	DataLoaderName string `json:"data_loader_name"`
+}
+
+type Service struct {
+	Name            string   `json:"name"`
+	OriginalName    string   `json:"original_name"`
+	CallInterface   GoType   `json:"call_interface"`
+	QueryMethods    []Method `json:"query_methods"`
+	MutationMethods []Method `json:"mutation_methods"`
+}
+
+type Method struct {
+	Name         string           `json:"name"`
+	OriginalName string           `json:"original_name"`
+	GraphQLType  string           `json:"graphql_type"`
+	RequestType  GoType           `json:"request_type"`
+	Arguments    []MethodArgument `json:"arguments"`
+}
+
+type MethodArgument struct {
+	Name        string `json:"name"`
+	GraphQLType string `json:"graphql_type"`
+}
+
+// Schema describes generated GraphQL schema.
+type Schema struct {
+	Name           string          `json:"name"`
+	Package        string          `json:"package"`
+	QueryObject    string          `json:"query_object"`
+	MutationObject string          `json:"mutation_object"`
+	Services       []SchemaService `json:"services"`
+	Objects        []SchemaObject  `json:"objects"`
+}
+
+type SchemaService struct {
+	Name    string   `json:"name"`
+	Package string   `json:"package"`
+	Fields  []string `json:"fields"`
+}
+
+type SchemaObject struct {
+	Name   string              `json:"name"`
+	Query  bool                `json:"query"`
+	Fields []SchemaObjectField `json:"fields"`
+}
+
+type SchemaObjectField struct {
+	Name    string `json:"name"`
+	Service string `json:"service,omitempty"`
+	Object  string `json:"object,omitempty"`
+}
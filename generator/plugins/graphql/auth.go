@@ -0,0 +1,139 @@
+package graphql
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/EGT-Ukraine/go2gql/generator"
+)
+
+const AuthPoliciesInfoKey = "auth-policies"
+
+// printAuthPolicies prints services methods policies and policies of schemas fields, so authorization coverage could be reviewed.
+func (p *Plugin) printAuthPolicies() {
+	fmt.Println("Services methods:")
+	for _, path := range TypesFilesPaths(p.files) {
+		for _, service := range p.files[path].Services {
+			for _, method := range service.QueryMethods {
+				fmt.Printf("\t%s.%s (query): %s\n", service.Name, method.Name, policyInfo(method.Auth))
+			}
+			for _, method := range service.MutationMethods {
+				fmt.Printf("\t%s.%s (mutation): %s\n", service.Name, method.Name, policyInfo(method.Auth))
+			}
+		}
+	}
+
+	for _, schema := range p.schemaConfigs {
+		fmt.Printf("Schema %s fields:\n", schema.Name)
+		objects, err := newSchemaParser(schema, p.files, p.entityDataLoaders).SchemaObjects()
+		if err != nil {
+			fmt.Printf("\tfailed to resolve schema objects: %s\n", err)
+
+			continue
+		}
+		for _, object := range objects.Objects {
+			for _, field := range object.Fields {
+				if field.Service == nil {
+					continue
+				}
+				var methodAuth *AuthConfig
+				if method := p.findServiceMethod(field.Service.Name, field.Name, object.QueryObject); method != nil {
+					methodAuth = method.Auth
+				}
+				fmt.Printf("\t%s.%s: schema: %s, method %s.%s: %s\n", object.Name, field.Name, policyInfo(field.Auth),
+					field.Service.Name, field.Name, policyInfo(methodAuth))
+			}
+		}
+	}
+}
+
+func (p *Plugin) findServiceMethod(serviceName, methodName string, query bool) *Method {
+	for _, path := range TypesFilesPaths(p.files) {
+		for _, service := range p.files[path].Services {
+			if service.Name != serviceName {
+				continue
+			}
+			methods := service.MutationMethods
+			if query {
+				methods = service.QueryMethods
+			}
+
+			return findMethod(methods, methodName)
+		}
+	}
+
+	return nil
+}
+
+func policyInfo(cfg *AuthConfig) string {
+	if cfg == nil {
+		return "NO POLICY"
+	}
+	var parts []string
+	if len(cfg.Scopes) > 0 {
+		parts = append(parts, "scopes ["+strings.Join(cfg.Scopes, ", ")+"]")
+	}
+	if len(cfg.Roles) > 0 {
+		parts = append(parts, "roles ["+strings.Join(cfg.Roles, ", ")+"]")
+	}
+	if cfg.Policy != "" {
+		parts = append(parts, "policy "+cfg.Policy)
+	}
+	if len(parts) == 0 {
+		return "authorizer only"
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+// validateAuthTemplate fails, if template override never references `Auth` context fields, but rendered context has policies.
+// Overrides, written before policies were added, would silently generate resolvers without authorization otherwise.
+func validateAuthTemplate(tpl *generator.Template, hasPolicies bool) error {
+	if tpl.OverridePath == "" || !hasPolicies || tpl.ReferencesField("Auth") {
+		return nil
+	}
+
+	return errors.Errorf("template override %s never references `.Auth`, but authorization policies are configured. "+
+		"Copy authorization blocks of embedded template to override", tpl.OverridePath)
+}
+
+func servicesHavePolicies(services []Service) bool {
+	for _, service := range services {
+		for _, method := range service.QueryMethods {
+			if method.Auth != nil {
+				return true
+			}
+		}
+		for _, method := range service.MutationMethods {
+			if method.Auth != nil {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func schemaHasPolicies(ctx SchemaBodyContext) bool {
+	for _, object := range ctx.Objects {
+		for _, field := range object.Fields {
+			if field.Auth != nil {
+				return true
+			}
+		}
+	}
+	for _, node := range ctx.Nodes {
+		if node.Auth != nil {
+			return true
+		}
+	}
+	for _, entity := range ctx.Entities {
+		if entity.Auth != nil {
+			return true
+		}
+	}
+
+	return false
+}
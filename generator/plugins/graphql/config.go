@@ -8,6 +8,7 @@ type SchemaNodeConfig struct {
 	Fields         []SchemaNodeConfig `mapstructure:"fields"`
 	ExcludeMethods []string           `mapstructure:"exclude_methods"`
 	FilterMethods  []string           `mapstructure:"filter_methods"`
+	Auth           *AuthConfig        `mapstructure:"auth"` // policy of node services methods, inherited by child nodes without own policy
 }
 type SchemaConfig struct {
 	Name          string            `mapstructure:"name"`
@@ -15,4 +16,65 @@ type SchemaConfig struct {
 	OutputPackage string            `mapstructure:"output_package"`
 	Queries       *SchemaNodeConfig `mapstructure:"queries"`
 	Mutations     *SchemaNodeConfig `mapstructure:"mutations"`
+	Federation    *FederationConfig `mapstructure:"federation"`
+	Relay         *RelayConfig      `mapstructure:"relay"`
+	Complexity    *ComplexityConfig `mapstructure:"complexity"`
+	Visibility    []string          `mapstructure:"visibility"` // visibility tags of schema. Elements, tagged only with other tags, are hidden
+}
+
+// FederationConfig enables Apollo Federation support of schema.
+type FederationConfig struct {
+	Entities []FederationEntityConfig `mapstructure:"entities"`
+}
+
+// FederationEntityConfig describes output object, which could be resolved by federation gateway.
+// Reference is resolved either by service query method or by data loader.
+type FederationEntityConfig struct {
+	Object     string   `mapstructure:"object"`      // GraphQL name of output object
+	Keys       []string `mapstructure:"keys"`        // fields sets of @key directives
+	Service    string   `mapstructure:"service"`     // service, which method resolves reference
+	Method     string   `mapstructure:"method"`      // query method, which takes key fields as arguments
+	DataLoader string   `mapstructure:"data_loader"` // data loader, which loads entity by the first key field
+}
+
+// RelayConfig enables Relay global object identification: `node` query field and `Node` interface.
+type RelayConfig struct {
+	Nodes []RelayNodeConfig `mapstructure:"nodes"`
+}
+
+// RelayNodeConfig declares output object as relay node.
+type RelayNodeConfig struct {
+	Object     string `mapstructure:"object"` // GraphQL name of output object
+	NodeConfig `mapstructure:",squash"`
+}
+
+// NodeConfig describes, how relay node global id is built and how node is refetched.
+// Node is refetched either by service query method or by data loader.
+type NodeConfig struct {
+	KeyField   string `mapstructure:"key_field"`   // object field, which value is encoded to global id
+	Service    string `mapstructure:"service"`     // service, which method refetches node
+	Method     string `mapstructure:"method"`      // query method, which takes key as argument
+	Argument   string `mapstructure:"argument"`    // method argument, which is filled with key. Default: key field name
+	DataLoader string `mapstructure:"data_loader"` // data loader, which loads node by key
+}
+
+// ComplexityConfig describes query depth and complexity limits of schema.
+type ComplexityConfig struct {
+	MaxDepth      int                        `mapstructure:"max_depth"`      // zero disables depth check
+	MaxComplexity int                        `mapstructure:"max_complexity"` // zero disables complexity check
+	DefaultCost   *int                       `mapstructure:"default_cost"`   // cost of fields without config. Default: 1
+	Multipliers   []string                   `mapstructure:"multipliers"`    // list size arguments of fields without config
+	Fields        map[string]FieldCostConfig `mapstructure:"fields"`         // fields costs by `Type.field` keys
+}
+
+type FieldCostConfig struct {
+	Cost        int      `mapstructure:"cost"`
+	Multipliers []string `mapstructure:"multipliers"` // dotted paths of list size or list arguments, which multiply field and it's children costs
+}
+
+// AuthConfig is an authorization policy of method or schema node, which is checked by `interceptors.Authorizer`.
+type AuthConfig struct {
+	Scopes []string `mapstructure:"scopes"` // required scopes
+	Roles  []string `mapstructure:"roles"`  // required roles
+	Policy string   `mapstructure:"policy"` // named policy
 }
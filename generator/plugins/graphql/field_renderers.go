@@ -2,17 +2,20 @@ package graphql
 
 import (
 	"bytes"
-	"text/template"
 
 	"github.com/pkg/errors"
+
+	"github.com/EGT-Ukraine/go2gql/generator"
 )
 
 type fieldsRenderer struct {
 	templateFuncs map[string]interface{}
+	generateCfg   *generator.GenerateConfig
 }
 
 type mapFieldsRenderer struct {
 	templateFuncs map[string]interface{}
+	generateCfg   *generator.GenerateConfig
 }
 
 type RenderFieldsContext struct {
@@ -22,11 +25,7 @@ type RenderFieldsContext struct {
 
 func (r *fieldsRenderer) RenderFields(o OutputObject, ctx BodyContext) (string, error) {
 	buf := new(bytes.Buffer)
-	tmpl, err := templatesOutput_fieldsGohtmlBytes()
-	if err != nil {
-		return "", errors.Wrap(err, "failed to get output fields template")
-	}
-	bodyTpl, err := template.New("fieldsRenderer").Funcs(r.templateFuncs).Parse(string(tmpl))
+	bodyTpl, err := r.generateCfg.ParseTemplate("output_fields.gohtml", Asset, r.templateFuncs)
 	if err != nil {
 		return "", errors.Wrap(err, "failed to parse template")
 	}
@@ -43,11 +42,7 @@ func (r *fieldsRenderer) RenderFields(o OutputObject, ctx BodyContext) (string,
 
 func (r *mapFieldsRenderer) RenderFields(o OutputObject, ctx BodyContext) (string, error) {
 	buf := new(bytes.Buffer)
-	tmpl, err := templatesOutput_map_fieldsGohtmlBytes()
-	if err != nil {
-		return "", errors.Wrap(err, "failed to get output fields template")
-	}
-	bodyTpl, err := template.New("mapFieldsRenderer").Funcs(r.templateFuncs).Parse(string(tmpl))
+	bodyTpl, err := r.generateCfg.ParseTemplate("output_map_fields.gohtml", Asset, r.templateFuncs)
 	if err != nil {
 		return "", errors.Wrap(err, "failed to parse template")
 	}
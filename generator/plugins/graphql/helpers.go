@@ -4,6 +4,7 @@ import (
 	"go/build"
 	"path/filepath"
 	"reflect"
+	"strconv"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -73,3 +74,28 @@ func IdentAccessValueResolver(ident string) ValueResolver {
 		return arg + "." + ident
 	}
 }
+
+// policyLiteral returns go literal of interceptors.Policy. interceptorsPkg is a prefix of interceptors package.
+func policyLiteral(interceptorsPkg string, cfg *AuthConfig) string {
+	var fields []string
+	if len(cfg.Scopes) > 0 {
+		fields = append(fields, "Scopes: "+stringsLiteral(cfg.Scopes))
+	}
+	if len(cfg.Roles) > 0 {
+		fields = append(fields, "Roles: "+stringsLiteral(cfg.Roles))
+	}
+	if cfg.Policy != "" {
+		fields = append(fields, "Name: "+strconv.Quote(cfg.Policy))
+	}
+
+	return interceptorsPkg + ".Policy{" + strings.Join(fields, ", ") + "}"
+}
+
+func stringsLiteral(values []string) string {
+	quoted := make([]string, len(values))
+	for i, value := range values {
+		quoted[i] = strconv.Quote(value)
+	}
+
+	return "[]string{" + strings.Join(quoted, ", ") + "}"
+}
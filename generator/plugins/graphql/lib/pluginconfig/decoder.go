@@ -7,8 +7,9 @@ func Decode(input, output interface{}) error {
 		DecodeHook: mapstructure.ComposeDecodeHookFunc(
 			mapstructure.StringToTimeDurationHookFunc(),
 		),
-		Metadata: nil,
-		Result:   output,
+		Metadata:    nil,
+		Result:      output,
+		ErrorUnused: true,
 	}
 
 	decoder, err := mapstructure.NewDecoder(config)
@@ -89,6 +89,7 @@ type InputObject struct {
 	VariableName string
 	GraphQLName  string
 	Fields       []ObjectField
+	Visibility   []string // visibility tags
 }
 
 type ObjectField struct {
@@ -99,6 +100,7 @@ type ObjectField struct {
 	Value         ValueResolver
 	NeedCast      bool
 	CastTo        GoType
+	Visibility    []string // visibility tags
 }
 
 type DataLoaderField struct {
@@ -109,6 +111,21 @@ type DataLoaderField struct {
 	KeyFieldSlice                bool
 	NormalizedParentKeyFieldName string
 	DataLoaderName               string
+	KeyFields                    []DataLoaderKeyField // parent key fields. Composite keys have more than one field.
+	Args                         []DataLoaderArg
+}
+
+type DataLoaderArg struct {
+	Name         string // graphql argument name
+	RequestField string // path of data loader request field, which is filled by argument
+}
+
+type DataLoaderKeyField struct {
+	Path     string // dotted path of key field in parent object
+	GoType   GoType
+	Repeated bool
+	Value    ValueResolver
+	Parsed   bool // value is parsed from string, parse error is stored to `keyErr` variable
 }
 
 type OutputObject struct {
@@ -118,6 +135,8 @@ type OutputObject struct {
 	Fields           []ObjectField
 	DataLoaderFields []*DataLoaderField // TODO: move to dataloader plugin
 	MapFields        []ObjectField
+	Node             *NodeConfig // set, if object implements relay Node interface
+	Visibility       []string    // visibility tags
 }
 
 func (s *OutputObject) FindFieldByName(name string) *ObjectField {
@@ -132,17 +151,41 @@ func (s *OutputObject) FindFieldByName(name string) *ObjectField {
 	return nil
 }
 
+// HasField reports, whether object has field, map field or data loader field with given GraphQL name.
+func (s *OutputObject) HasField(name string) bool {
+	for _, field := range s.Fields {
+		if field.Name == name {
+			return true
+		}
+	}
+	for _, field := range s.MapFields {
+		if field.Name == name {
+			return true
+		}
+	}
+	for _, field := range s.DataLoaderFields {
+		if field.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
 type Enum struct {
 	VariableName string
 	GraphQLName  string
 	Comment      string
 	Values       []EnumValue
+	Visibility   []string // visibility tags
+	UnknownValue string   // name of fallback value, which values unknown to enum are resolved to. Empty disables fallback
 }
 
 type EnumValue struct {
-	Name    string
-	Value   int
-	Comment string
+	Name       string
+	Value      int
+	Comment    string
+	Visibility []string // visibility tags
 }
 
 type MapInputObject struct {
@@ -191,15 +234,19 @@ type Method struct {
 	RequestType            GoType
 	PayloadErrorChecker    PayloadErrorChecker
 	PayloadErrorAccessor   PayloadErrorAccessor
+	Auth                   *AuthConfig // authorization policy, checked before arguments resolving
+	Visibility             []string    // visibility tags
 }
 
 type MethodArgument struct {
 	Name          string
 	Type          TypeResolver
 	QuotedComment string
+	Visibility    []string // visibility tags
 }
 
 type TypesFile struct {
+	Source                  string // file, which types were generated from
 	PackageName             string
 	Package                 string
 	Enums                   []Enum
@@ -236,6 +283,12 @@ type SchemaBodyContext struct {
 	MutationObject string
 	Objects        []*gqlObject
 	TracerEnabled  bool
+	Federation     bool
+	Entities       []SchemaEntity
+	Relay          bool
+	Nodes          []SchemaNode
+	Complexity     *SchemaComplexity
+	Hidden         *SchemaHidden
 }
 
 type SchemaService struct {
@@ -246,11 +299,59 @@ type SchemaService struct {
 	ClientGoType    GoType
 }
 
+// SchemaEntity is a federation entity of schema.
+type SchemaEntity struct {
+	Object     OutputObject
+	Pkg        string // go package of output object
+	Keys       []string
+	Service    *SchemaService // service, which query method resolves reference
+	Method     string
+	DataLoader *EntityDataLoader // data loader, which resolves reference, if service is not set
+	Auth       *AuthConfig       // schema node policy, checked before reference is resolved
+}
+
+// SchemaComplexity is a query depth and complexity limits of schema.
+type SchemaComplexity struct {
+	MaxDepth      int
+	MaxComplexity int
+	DefaultCost   int
+	Multipliers   []string
+	Fields        map[string]FieldCostConfig
+}
+
+// SchemaHidden lists types, fields, arguments and enum values, which are hidden from schema by visibility tags.
+type SchemaHidden struct {
+	Types      []string
+	Fields     []string // `Type.field` keys
+	Arguments  []string // `Type.field.argument` keys
+	EnumValues []string // `Enum.VALUE` keys
+}
+
+// SchemaNode is a relay node of schema.
+type SchemaNode struct {
+	Object     OutputObject
+	Pkg        string         // go package of output object
+	Service    *SchemaService // service, which query method refetches node
+	Method     string
+	Argument   string            // method argument, which is filled with key
+	DataLoader *EntityDataLoader // data loader, which refetches node, if service is not set
+	Auth       *AuthConfig       // schema node policy, checked before node is refetched
+}
+
+// EntityDataLoader is a data loader, which could resolve federation entities references and relay nodes by scalar key.
+type EntityDataLoader struct {
+	Name         string
+	LoadersPkg   string // go package of generated data loaders
+	KeyGoType    GoType
+	OutputGoType GoType
+}
+
 type fieldConfig struct {
 	QuotedComment string
 	Name          string
 	Service       *SchemaService
 	Object        *gqlObject
+	Auth          *AuthConfig // schema node policy of service method field
 }
 
 type gqlObject struct {
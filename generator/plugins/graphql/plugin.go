@@ -1,11 +1,12 @@
 package graphql
 
 import (
+	"bytes"
 	"fmt"
-	"os"
 	"path/filepath"
+	"reflect"
+	"sort"
 
-	"github.com/mitchellh/mapstructure"
 	"github.com/pkg/errors"
 
 	"github.com/EGT-Ukraine/go2gql/generator"
@@ -15,6 +16,8 @@ import (
 const (
 	PluginName        = "graphql"
 	SchemasConfigsKey = "graphql_schemas"
+
+	nodeIDFieldName = "id" // global id field, which relay.GlobalIDField adds to nodes
 )
 
 type Plugin struct {
@@ -22,6 +25,7 @@ type Plugin struct {
 	schemaConfigs              []SchemaConfig
 	generateCfg                *generator.GenerateConfig
 	outputObjectFieldRenderers []OutputObjectFieldRender
+	entityDataLoaders          map[string]EntityDataLoader
 }
 
 type SchemaObjects struct {
@@ -40,7 +44,8 @@ func (p *Plugin) Prepare() error {
 func (p *Plugin) Init(config *generator.GenerateConfig, plugins []generator.Plugin) error {
 	var cfgs []SchemaConfig
 	p.files = make(map[string]*TypesFile)
-	err := mapstructure.Decode(config.PluginsConfigs[SchemasConfigsKey], &cfgs)
+	p.entityDataLoaders = make(map[string]EntityDataLoader)
+	err := config.DecodePluginConfig(SchemasConfigsKey, &cfgs)
 	if err != nil {
 		return errors.Wrap(err, "failed to decode config")
 	}
@@ -59,7 +64,7 @@ func (p *Plugin) parseImports() error {
 	for _, pluginsConfigsImports := range p.generateCfg.PluginsConfigsImports {
 		cfg := new([]*SchemaConfig)
 
-		if err := mapstructure.Decode(pluginsConfigsImports.PluginsConfigs[SchemasConfigsKey], cfg); err != nil {
+		if err := pluginsConfigsImports.DecodePluginConfig(SchemasConfigsKey, cfg); err != nil {
 			return errors.Wrap(err, "failed to decode config")
 		}
 
@@ -96,10 +101,30 @@ func (p *Plugin) parseImportedSchema(cfg *SchemaConfig) error {
 
 	if cfg.Queries != nil {
 		schema.Queries.Fields = p.mergeFields(schema.Queries.Fields, cfg.Queries.Fields)
+		if schema.Queries.Auth == nil {
+			schema.Queries.Auth = cfg.Queries.Auth
+		}
 	}
 
 	if cfg.Mutations != nil {
 		schema.Mutations.Fields = p.mergeFields(schema.Mutations.Fields, cfg.Mutations.Fields)
+		if schema.Mutations.Auth == nil {
+			schema.Mutations.Auth = cfg.Mutations.Auth
+		}
+	}
+
+	if cfg.Federation != nil {
+		if schema.Federation == nil {
+			schema.Federation = new(FederationConfig)
+		}
+		schema.Federation.Entities = append(schema.Federation.Entities, cfg.Federation.Entities...)
+	}
+
+	if cfg.Relay != nil {
+		if schema.Relay == nil {
+			schema.Relay = new(RelayConfig)
+		}
+		schema.Relay.Nodes = append(schema.Relay.Nodes, cfg.Relay.Nodes...)
 	}
 
 	return nil
@@ -111,6 +136,9 @@ func (p *Plugin) mergeFields(schemaFields []SchemaNodeConfig, importFields []Sch
 
 		if nodeIdx != -1 {
 			schemaFields[nodeIdx].Fields = p.mergeFields(schemaFields[nodeIdx].Fields, importField.Fields)
+			if schemaFields[nodeIdx].Auth == nil {
+				schemaFields[nodeIdx].Auth = importField.Auth
+			}
 		} else {
 			schemaFields = append(schemaFields, importField)
 		}
@@ -130,9 +158,9 @@ func (p *Plugin) findNodeIndex(name string, schemaFields []SchemaNodeConfig) int
 }
 
 func (p *Plugin) findSchemaByName(name string) *SchemaConfig {
-	for _, schema := range p.schemaConfigs {
-		if schema.Name == name {
-			return &schema
+	for i := range p.schemaConfigs {
+		if p.schemaConfigs[i].Name == name {
+			return &p.schemaConfigs[i]
 		}
 	}
 
@@ -144,6 +172,23 @@ func (p *Plugin) Types() map[string]*TypesFile {
 	return p.files
 }
 
+// TypesFilesPaths returns sorted output paths of types files. Types files should be iterated in this order,
+// so generated code doesn't depend on map iteration order.
+func TypesFilesPaths(files map[string]*TypesFile) []string {
+	res := make([]string, 0, len(files))
+	for path := range files {
+		res = append(res, path)
+	}
+	sort.Strings(res)
+
+	return res
+}
+
+// Templates returns names of plugin templates, which could be overridden.
+func (p *Plugin) Templates() []string {
+	return generator.TemplatesNames(AssetNames())
+}
+
 func (p *Plugin) AddTypesFile(outputPath string, file *TypesFile) {
 	p.files[outputPath] = file
 }
@@ -154,7 +199,8 @@ func (p Plugin) Name() string {
 
 func (p *Plugin) PrintInfo(infos generator.Infos) {
 	if infos.Contains("gql-services") {
-		for path, file := range p.files {
+		for _, path := range TypesFilesPaths(p.files) {
+			file := p.files[path]
 			if len(file.Services) > 0 {
 				fmt.Println(path)
 				for _, service := range file.Services {
@@ -163,11 +209,15 @@ func (p *Plugin) PrintInfo(infos generator.Infos) {
 			}
 		}
 	}
+	if infos.Contains(AuthPoliciesInfoKey) {
+		p.printAuthPolicies()
+	}
 }
 
 func (p *Plugin) Infos() map[string]string {
 	return map[string]string{
-		"gql-services": "Info about all parsed GraphQL services",
+		"gql-services":      "Info about all parsed GraphQL services",
+		AuthPoliciesInfoKey: "Authorization policies of all services methods and schemas fields",
 	}
 }
 
@@ -175,6 +225,11 @@ type OutputObjectFieldRender interface {
 	RenderFields(o OutputObject, ctx BodyContext) (string, error)
 }
 
+// AddEntityDataLoader registers data loader, which could be used to resolve federation entities references and relay nodes.
+func (p *Plugin) AddEntityDataLoader(loader EntityDataLoader) {
+	p.entityDataLoaders[loader.Name] = loader
+}
+
 func (p *Plugin) AddOutputObjectFieldRenderer(renderer OutputObjectFieldRender) {
 	p.outputObjectFieldRenderers = append(p.outputObjectFieldRenderers, renderer)
 }
@@ -182,7 +237,8 @@ func (p *Plugin) AddOutputObjectFieldRenderer(renderer OutputObjectFieldRender)
 func (p *Plugin) validateInputObjects() error {
 	objectNames := map[string]string{}
 
-	for _, file := range p.files {
+	for _, path := range TypesFilesPaths(p.files) {
+		file := p.files[path]
 		for _, fileInputObjects := range file.InputObjects {
 			name := fileInputObjects.GraphQLName
 
@@ -197,37 +253,95 @@ func (p *Plugin) validateInputObjects() error {
 	return nil
 }
 
+// resolveNodes marks output objects, declared as relay nodes in schemas configs, and validates nodes keys fields.
+// Nodes are shared between schemas, as their types are.
+func (p *Plugin) resolveNodes() error {
+	for _, schema := range p.schemaConfigs {
+		if schema.Relay == nil {
+			continue
+		}
+		for _, nodeCfg := range schema.Relay.Nodes {
+			object := p.findOutputObject(nodeCfg.Object)
+			if object == nil {
+				return errors.Errorf("schema %s node %s output object not found", schema.Name, nodeCfg.Object)
+			}
+			if object.Node != nil && *object.Node != nodeCfg.NodeConfig {
+				return errors.Errorf("output object %s is declared as node with different configs", nodeCfg.Object)
+			}
+			node := nodeCfg.NodeConfig
+			object.Node = &node
+		}
+	}
+
+	for _, path := range TypesFilesPaths(p.files) {
+		for _, object := range p.files[path].OutputObjects {
+			if object.Node == nil {
+				continue
+			}
+			if object.HasField(nodeIDFieldName) {
+				return errors.Errorf("node %s own field %s conflicts with node global id field. Rename it with field config name", object.GraphQLName, nodeIDFieldName)
+			}
+			if object.Node.KeyField == "" {
+				return errors.Errorf("node %s key field must be set", object.GraphQLName)
+			}
+			keyField := object.FindFieldByName(object.Node.KeyField)
+			if keyField == nil {
+				return errors.Errorf("node %s key field %s not found", object.GraphQLName, object.Node.KeyField)
+			}
+			keyType := keyField.GoType
+			if keyType.Kind == reflect.Ptr {
+				keyType = *keyType.ElemType
+			}
+			if !typeIsScalar(keyType) {
+				return errors.Errorf("node %s key field %s must be scalar", object.GraphQLName, object.Node.KeyField)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (p *Plugin) findOutputObject(name string) *OutputObject {
+	for _, path := range TypesFilesPaths(p.files) {
+		file := p.files[path]
+		for i := range file.OutputObjects {
+			if file.OutputObjects[i].GraphQLName == name {
+				return &file.OutputObjects[i]
+			}
+		}
+	}
+
+	return nil
+}
+
 func (p *Plugin) generateTypes() error {
 	if err := p.validateInputObjects(); err != nil {
 		return errors.Wrap(err, "failed to validate input objects")
 	}
 
-	for outputPath, file := range p.files {
-		err := os.MkdirAll(filepath.Dir(outputPath), 0777)
-		if err != nil {
-			return errors.Wrapf(err, "failed to create directories for output types file %s", outputPath)
-		}
-		out, err := os.OpenFile(outputPath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0777)
-		if err != nil {
-			return errors.Wrapf(err, "failed to open file %s for write", outputPath)
-		}
-		err = typesGenerator{
+	for _, outputPath := range TypesFilesPaths(p.files) {
+		file := p.files[outputPath]
+		out := new(bytes.Buffer)
+		err := typesGenerator{
 			File:          file,
 			tracerEnabled: p.generateCfg.GenerateTraces,
 			imports: &importer.Importer{
 				CurrentPackage: file.Package,
 			},
 			outputObjectFieldRenderers: p.outputObjectFieldRenderers,
+			generateCfg:                p.generateCfg,
 		}.generate(out)
 		if err != nil {
-			if cerr := out.Close(); cerr != nil {
-				err = errors.Wrap(err, cerr.Error())
-			}
-
 			return errors.Wrapf(err, "failed to generate types file %s", outputPath)
 		}
-		if err = out.Close(); err != nil {
-			return errors.Wrapf(err, "failed to close generated types file %s", outputPath)
+		err = p.generateCfg.Output.WriteFile(generator.File{
+			Path:    outputPath,
+			Plugin:  PluginName,
+			Source:  file.Source,
+			Content: out.Bytes(),
+		})
+		if err != nil {
+			return errors.Wrapf(err, "failed to write generated types file %s", outputPath)
 		}
 	}
 
@@ -243,7 +357,7 @@ func (p *Plugin) SchemasObjects() ([]SchemaObjects, error) {
 		if err != nil {
 			return nil, errors.Wrapf(err, "failed to resolve schema %s output go package", schema.Name)
 		}
-		parser := newSchemaParser(schema, p.files)
+		parser := newSchemaParser(schema, p.files, p.entityDataLoaders)
 
 		schemaContext, err := parser.SchemaObjects()
 
@@ -267,6 +381,10 @@ func (p *Plugin) SchemasObjects() ([]SchemaObjects, error) {
 }
 
 func (p *Plugin) Generate() error {
+	if err := p.resolveNodes(); err != nil {
+		return errors.Wrap(err, "failed to resolve relay nodes")
+	}
+
 	if err := p.generateTypes(); err != nil {
 		return errors.Wrap(err, "failed to generate types files")
 	}
@@ -1,6 +1,7 @@
 package graphql
 
 import (
+	"reflect"
 	"testing"
 
 	"github.com/pkg/errors"
@@ -106,6 +107,122 @@ graphql_schemas:
 	})
 }
 
+func TestSchemaFederationMerge(t *testing.T) {
+	Convey("Given config with federation entities in import", t, func() {
+		var mainConfig = `
+graphql_schemas:
+  - name: "API"
+    output_path: "./services_api/schema/api.go"
+    output_package: "schema"
+    federation:
+      entities:
+        - object: "User"
+          keys: ["id"]
+          service: "Users"
+          method: "getUser"
+`
+
+		var importConfig = `
+graphql_schemas:
+  - name: "API"
+    federation:
+      entities:
+        - object: "Order"
+          keys: ["id", "number"]
+          data_loader: "OrdersLoader"
+`
+		gc, err := parseConfigs(mainConfig, importConfig)
+
+		So(err, ShouldBeNil)
+
+		Convey("When the graphql plugin is initialized", func() {
+			graphqlPlugin := new(Plugin)
+
+			if err := graphqlPlugin.Init(gc, []generator.Plugin{}); err != nil {
+				t.Fatalf(err.Error())
+			}
+
+			Convey("Federation entities should be merged", func() {
+				So(graphqlPlugin.schemaConfigs[0].Federation, ShouldResemble, &FederationConfig{
+					Entities: []FederationEntityConfig{
+						{
+							Object:  "User",
+							Keys:    []string{"id"},
+							Service: "Users",
+							Method:  "getUser",
+						},
+						{
+							Object:     "Order",
+							Keys:       []string{"id", "number"},
+							DataLoader: "OrdersLoader",
+						},
+					},
+				})
+			})
+		})
+	})
+}
+
+func TestResolveNodes(t *testing.T) {
+	Convey("Given output objects and schema relay config", t, func() {
+		newPlugin := func(keyField string, object *NodeConfig) *Plugin {
+			return &Plugin{
+				files: map[string]*TypesFile{
+					"types.go": {
+						OutputObjects: []OutputObject{
+							{
+								GraphQLName: "User",
+								Fields: []ObjectField{
+									{Name: "user_id", GoType: GoType{Kind: reflect.Int64}},
+									{Name: "profile", GoType: GoType{Kind: reflect.Struct, Name: "Profile"}},
+								},
+								Node: object,
+							},
+						},
+					},
+				},
+				schemaConfigs: []SchemaConfig{
+					{
+						Name: "API",
+						Relay: &RelayConfig{
+							Nodes: []RelayNodeConfig{
+								{
+									Object:     "User",
+									NodeConfig: NodeConfig{KeyField: keyField, DataLoader: "UsersByIDs"},
+								},
+							},
+						},
+					},
+				},
+			}
+		}
+
+		Convey("Schema nodes should be marked on output objects", func() {
+			p := newPlugin("user_id", nil)
+			So(p.resolveNodes(), ShouldBeNil)
+			So(p.files["types.go"].OutputObjects[0].Node, ShouldResemble, &NodeConfig{KeyField: "user_id", DataLoader: "UsersByIDs"})
+		})
+		Convey("Nodes declared with different configs should fail", func() {
+			p := newPlugin("user_id", &NodeConfig{KeyField: "user_id", Service: "Users", Method: "getUser"})
+			So(p.resolveNodes(), ShouldNotBeNil)
+		})
+		Convey("Nodes with missing or not scalar key field should fail", func() {
+			So(newPlugin("login", nil).resolveNodes(), ShouldNotBeNil)
+			So(newPlugin("profile", nil).resolveNodes(), ShouldNotBeNil)
+		})
+		Convey("Nodes with own id field should fail", func() {
+			p := newPlugin("user_id", nil)
+			object := &p.files["types.go"].OutputObjects[0]
+			object.Fields = append(object.Fields, ObjectField{Name: "id", GoType: GoType{Kind: reflect.String}})
+			So(p.resolveNodes(), ShouldNotBeNil)
+
+			object.Fields = object.Fields[:len(object.Fields)-1]
+			object.DataLoaderFields = append(object.DataLoaderFields, &DataLoaderField{Name: "id"})
+			So(p.resolveNodes(), ShouldNotBeNil)
+		})
+	})
+}
+
 func parseConfigs(mainConfig string, importConfig string) (*generator.GenerateConfig, error) {
 	gc := new(generator.GenerateConfig)
 
@@ -128,3 +245,234 @@ func parseConfigs(mainConfig string, importConfig string) (*generator.GenerateCo
 
 	return gc, nil
 }
+
+func TestSchemaAuthInheritance(t *testing.T) {
+	Convey("Given schema with nodes policies", t, func() {
+		files := map[string]*TypesFile{
+			"types.go": {
+				Package: "types",
+				Services: []Service{
+					{Name: "Users", QueryMethods: []Method{{Name: "getUser"}}},
+					{Name: "Admin", QueryMethods: []Method{{Name: "getStats"}}},
+				},
+			},
+		}
+		rootAuth := &AuthConfig{Scopes: []string{"api"}}
+		adminAuth := &AuthConfig{Roles: []string{"admin"}}
+		schemaCfg := SchemaConfig{
+			Name: "API",
+			Queries: &SchemaNodeConfig{
+				Type:       SchemaNodeTypeObject,
+				ObjectName: "Query",
+				Auth:       rootAuth,
+				Fields: []SchemaNodeConfig{
+					{Type: SchemaNodeTypeService, Field: "users", ObjectName: "Users", Service: "Users"},
+					{Type: SchemaNodeTypeService, Field: "admin", ObjectName: "Admin", Service: "Admin", Auth: adminAuth},
+				},
+			},
+		}
+
+		objects, err := newSchemaParser(schemaCfg, files, nil).SchemaObjects()
+		So(err, ShouldBeNil)
+
+		fieldsAuth := make(map[string]*AuthConfig)
+		for _, object := range objects.Objects {
+			for _, field := range object.Fields {
+				if field.Service != nil {
+					fieldsAuth[object.Name+"."+field.Name] = field.Auth
+				}
+			}
+		}
+
+		Convey("Nodes without own policy should inherit parent policy", func() {
+			So(fieldsAuth["Users.getUser"], ShouldEqual, rootAuth)
+		})
+		Convey("Nodes policies should override parent policy", func() {
+			So(fieldsAuth["Admin.getStats"], ShouldEqual, adminAuth)
+		})
+	})
+}
+
+func TestSchemaObjectsResolversAuth(t *testing.T) {
+	Convey("Given schema with relay nodes and federation entities", t, func() {
+		userType := GoType{Kind: reflect.Struct, Pkg: "users", Name: "User"}
+		groupType := GoType{Kind: reflect.Struct, Pkg: "users", Name: "Group"}
+		files := map[string]*TypesFile{
+			"types.go": {
+				Package: "types",
+				OutputObjects: []OutputObject{
+					{
+						GraphQLName: "User",
+						GoType:      userType,
+						Fields:      []ObjectField{{Name: "id", GoType: GoType{Kind: reflect.Int64, Scalar: true}}},
+						Node:        &NodeConfig{KeyField: "id", Service: "Users", Method: "getUser"},
+					},
+					{
+						GraphQLName: "Group",
+						GoType:      groupType,
+						Fields:      []ObjectField{{Name: "id", GoType: GoType{Kind: reflect.Int64, Scalar: true}}},
+						Node:        &NodeConfig{KeyField: "id", DataLoader: "GroupsByIDs"},
+					},
+				},
+				Services: []Service{
+					{Name: "Users", QueryMethods: []Method{{Name: "getUser", Arguments: []MethodArgument{{Name: "id"}}}}},
+				},
+			},
+		}
+		dataLoaders := map[string]EntityDataLoader{
+			"GroupsByIDs": {Name: "GroupsByIDs", OutputGoType: GoType{Kind: reflect.Ptr, ElemType: &groupType}},
+		}
+		rootAuth := &AuthConfig{Scopes: []string{"api"}}
+		usersAuth := &AuthConfig{Roles: []string{"support"}}
+		schemaCfg := SchemaConfig{
+			Name: "API",
+			Queries: &SchemaNodeConfig{
+				Type:       SchemaNodeTypeObject,
+				ObjectName: "Query",
+				Auth:       rootAuth,
+				Fields: []SchemaNodeConfig{
+					{Type: SchemaNodeTypeService, Field: "users", ObjectName: "Users", Service: "Users", Auth: usersAuth},
+				},
+			},
+			Relay: &RelayConfig{},
+			Federation: &FederationConfig{
+				Entities: []FederationEntityConfig{
+					{Object: "User", Keys: []string{"id"}, Service: "Users", Method: "getUser"},
+					{Object: "Group", Keys: []string{"id"}, DataLoader: "GroupsByIDs"},
+				},
+			},
+		}
+
+		objects, err := newSchemaParser(schemaCfg, files, dataLoaders).SchemaObjects()
+		So(err, ShouldBeNil)
+		So(objects.Nodes, ShouldHaveLength, 2)
+		So(objects.Entities, ShouldHaveLength, 2)
+
+		Convey("Objects, resolved by exposed methods, should have policy of method schema node", func() {
+			So(objects.Nodes[0].Object.GraphQLName, ShouldEqual, "User")
+			So(objects.Nodes[0].Auth, ShouldEqual, usersAuth)
+			So(objects.Entities[0].Auth, ShouldEqual, usersAuth)
+		})
+		Convey("Objects, resolved by data loaders, should have queries policy", func() {
+			So(objects.Nodes[1].Object.GraphQLName, ShouldEqual, "Group")
+			So(objects.Nodes[1].Auth, ShouldEqual, rootAuth)
+			So(objects.Entities[1].Auth, ShouldEqual, rootAuth)
+		})
+	})
+}
+
+func TestSchemaVisibility(t *testing.T) {
+	Convey("Given types with visibility tags", t, func() {
+		internal := []string{"internal"}
+		files := map[string]*TypesFile{
+			"types.go": {
+				Package: "types",
+				Enums: []Enum{
+					{GraphQLName: "Status", Values: []EnumValue{{Name: "ACTIVE"}, {Name: "DELETED", Visibility: internal}}},
+					{GraphQLName: "AuditKind", Visibility: internal},
+				},
+				InputObjects: []InputObject{
+					{GraphQLName: "UserInput", Fields: []ObjectField{{Name: "name"}, {Name: "notes", Visibility: internal}}},
+				},
+				OutputObjects: []OutputObject{
+					{GraphQLName: "User", Fields: []ObjectField{{Name: "name"}}, MapFields: []ObjectField{{Name: "labels", Visibility: internal}}},
+					{GraphQLName: "Audit", Visibility: internal},
+				},
+				Services: []Service{
+					{
+						Name: "Users",
+						QueryMethods: []Method{
+							{Name: "getUser", Arguments: []MethodArgument{{Name: "id"}, {Name: "debug", Visibility: internal}}},
+							{Name: "getAudit", Visibility: []string{"internal", "admin"}},
+						},
+					},
+				},
+			},
+		}
+		newSchemaObjects := func(tags []string) *SchemaParserObjects {
+			objects, err := newSchemaParser(SchemaConfig{
+				Name:       "API",
+				Queries:    &SchemaNodeConfig{Type: SchemaNodeTypeService, Service: "Users"},
+				Visibility: tags,
+			}, files, nil).SchemaObjects()
+			So(err, ShouldBeNil)
+
+			return objects
+		}
+		queryFields := func(objects *SchemaParserObjects) []string {
+			var res []string
+			for _, field := range objects.Objects[0].Fields {
+				res = append(res, field.Name)
+			}
+
+			return res
+		}
+
+		Convey("Schema without visibility tags should contain all elements", func() {
+			objects := newSchemaObjects(nil)
+			So(queryFields(objects), ShouldResemble, []string{"getUser", "getAudit"})
+			So(objects.Hidden, ShouldBeNil)
+		})
+		Convey("Schema should hide elements, tagged only with other tags", func() {
+			objects := newSchemaObjects([]string{"public"})
+			So(queryFields(objects), ShouldResemble, []string{"getUser"})
+			So(objects.Hidden, ShouldResemble, &SchemaHidden{
+				Types:      []string{"AuditKind", "Audit"},
+				Fields:     []string{"UserInput.notes", "User.labels"},
+				Arguments:  []string{"Query.getUser.debug"},
+				EnumValues: []string{"Status.DELETED"},
+			})
+		})
+		Convey("Schema should contain elements with any of schema tags", func() {
+			objects := newSchemaObjects([]string{"public", "admin"})
+			So(queryFields(objects), ShouldResemble, []string{"getUser", "getAudit"})
+		})
+	})
+}
+
+func TestEntitiesVisibility(t *testing.T) {
+	Convey("Given federation entity with visibility tags", t, func() {
+		internal := []string{"internal"}
+		groupType := GoType{Kind: reflect.Struct, Pkg: "users", Name: "Group"}
+		dataLoaders := map[string]EntityDataLoader{
+			"GroupsByIDs": {Name: "GroupsByIDs", OutputGoType: GoType{Kind: reflect.Ptr, ElemType: &groupType}},
+		}
+		resolveEntities := func(key string, fields ...ObjectField) ([]SchemaEntity, error) {
+			files := map[string]*TypesFile{
+				"types.go": {
+					Package:       "types",
+					OutputObjects: []OutputObject{{GraphQLName: "Group", GoType: groupType, Fields: fields}},
+				},
+			}
+
+			return newSchemaParser(SchemaConfig{
+				Name:       "API",
+				Queries:    &SchemaNodeConfig{Type: SchemaNodeTypeObject, ObjectName: "Query"},
+				Visibility: []string{"public"},
+				Federation: &FederationConfig{
+					Entities: []FederationEntityConfig{{Object: "Group", Keys: []string{key}, DataLoader: "GroupsByIDs"}},
+				},
+			}, files, dataLoaders).resolveEntities()
+		}
+
+		Convey("Entity with visible key should be resolved", func() {
+			entities, err := resolveEntities("id", ObjectField{Name: "id"}, ObjectField{Name: "notes", Visibility: internal})
+			So(err, ShouldBeNil)
+			So(entities, ShouldHaveLength, 1)
+		})
+		Convey("Entity with all fields hidden should be rejected", func() {
+			_, err := resolveEntities("id", ObjectField{Name: "id", Visibility: internal}, ObjectField{Name: "notes", Visibility: internal})
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldContainSubstring, "all output object fields are hidden")
+		})
+		Convey("Entity with hidden key field should be rejected", func() {
+			_, err := resolveEntities("id", ObjectField{Name: "id", Visibility: internal}, ObjectField{Name: "name"})
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldContainSubstring, "key 'id' field id is hidden")
+
+			_, err = resolveEntities("name org { id }", ObjectField{Name: "name"}, ObjectField{Name: "org", Visibility: internal})
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldContainSubstring, "field org is hidden")
+		})
+	})
+}
@@ -1,6 +1,7 @@
 package graphql
 
 import (
+	"reflect"
 	"strconv"
 	"strings"
 
@@ -12,15 +13,20 @@ type SchemaParserObjects struct {
 	QueryObject    string
 	MutationObject string
 	Objects        []*gqlObject
+	Entities       []SchemaEntity
+	Nodes          []SchemaNode
+	Complexity     *SchemaComplexity
+	Hidden         *SchemaHidden
 }
 
 type schemaParser struct {
-	schemaCfg SchemaConfig
-	types     map[string]*TypesFile
+	schemaCfg   SchemaConfig
+	types       map[string]*TypesFile
+	dataLoaders map[string]EntityDataLoader
 }
 
-func newSchemaParser(schemaCfg SchemaConfig, types map[string]*TypesFile) *schemaParser {
-	return &schemaParser{schemaCfg, types}
+func newSchemaParser(schemaCfg SchemaConfig, types map[string]*TypesFile, dataLoaders map[string]EntityDataLoader) *schemaParser {
+	return &schemaParser{schemaCfg, types, dataLoaders}
 }
 
 func (g *schemaParser) resolveObjectFields(nodeCfg SchemaNodeConfig, object *gqlObject) (services []SchemaService, newObjectx []*gqlObject, err error) {
@@ -29,6 +35,9 @@ func (g *schemaParser) resolveObjectFields(nodeCfg SchemaNodeConfig, object *gql
 	switch nodeCfg.Type {
 	case SchemaNodeTypeObject:
 		for _, fld := range nodeCfg.Fields {
+			if fld.Auth == nil {
+				fld.Auth = nodeCfg.Auth
+			}
 			fldObj := &gqlObject{
 				QueryObject:   object.QueryObject,
 				QuotedComment: strconv.Quote(fld.Field + " result type"),
@@ -76,10 +85,12 @@ func (g *schemaParser) resolveObjectFields(nodeCfg SchemaNodeConfig, object *gql
 			serviceMethods = service.MutationMethods
 		}
 
-		meths := make([]string, len(serviceMethods))
+		var meths []string
 
-		for i, meth := range serviceMethods {
-			meths[i] = meth.Name
+		for _, meth := range serviceMethods {
+			if visible(meth.Visibility, g.schemaCfg.Visibility) {
+				meths = append(meths, meth.Name)
+			}
 		}
 		fields := g.filterMethods(meths, nodeCfg.FilterMethods, nodeCfg.ExcludeMethods)
 		srv := SchemaService{
@@ -92,6 +103,7 @@ func (g *schemaParser) resolveObjectFields(nodeCfg SchemaNodeConfig, object *gql
 			object.Fields = append(object.Fields, fieldConfig{
 				Name:    fld,
 				Service: &srv,
+				Auth:    nodeCfg.Auth,
 			})
 		}
 
@@ -121,7 +133,8 @@ func (g *schemaParser) objectComment(fld SchemaNodeConfig) (string, error) {
 }
 
 func (g *schemaParser) findServiceByName(serviceName string) (*Service, string) {
-	for _, typesFile := range g.types {
+	for _, path := range TypesFilesPaths(g.types) {
+		typesFile := g.types[path]
 		for _, service := range typesFile.Services {
 			if service.Name == serviceName {
 				return &service, typesFile.Package
@@ -216,14 +229,360 @@ func (g schemaParser) SchemaObjects() (*SchemaParserObjects, error) {
 		return nil, errors.Wrap(err, "failed to validate objects")
 	}
 
+	entities, err := g.resolveEntities()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to resolve federation entities")
+	}
+	for i, entity := range entities {
+		if entity.Service != nil && !containsService(services, entity.Service.Name) {
+			services = append(services, *entity.Service)
+		}
+		entities[i].Auth = g.objectResolverAuth(objects, entity.Service, entity.Method)
+	}
+
+	complexity, err := g.resolveComplexity(objects)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to resolve complexity limits")
+	}
+
+	nodes, err := g.resolveNodes()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to resolve relay nodes")
+	}
+	for i, node := range nodes {
+		if node.Service != nil && !containsService(services, node.Service.Name) {
+			services = append(services, *node.Service)
+		}
+		nodes[i].Auth = g.objectResolverAuth(objects, node.Service, node.Method)
+	}
+
 	return &SchemaParserObjects{
 		QueryObject:    queryObj,
 		MutationObject: mutationsObj,
 		Objects:        objects,
 		Services:       services,
+		Entities:       entities,
+		Nodes:          nodes,
+		Complexity:     complexity,
+		Hidden:         g.resolveHidden(objects),
+	}, nil
+}
+
+func (g *schemaParser) resolveEntities() ([]SchemaEntity, error) {
+	if g.schemaCfg.Federation == nil {
+		return nil, nil
+	}
+	if g.schemaCfg.Queries == nil {
+		return nil, errors.New("federated schema must have queries")
+	}
+
+	var res []SchemaEntity
+	goTypes := make(map[string]string)
+	for _, cfg := range g.schemaCfg.Federation.Entities {
+		entity, err := g.resolveEntity(cfg)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to resolve entity %s", cfg.Object)
+		}
+		goType := entity.Object.GoType.Pkg + "." + entity.Object.GoType.Name
+		if object, ok := goTypes[goType]; ok {
+			return nil, errors.Errorf("entities %s and %s have the same go type, so they can't be distinguished", object, cfg.Object)
+		}
+		goTypes[goType] = cfg.Object
+		res = append(res, entity)
+	}
+
+	return res, nil
+}
+
+func (g *schemaParser) resolveEntity(cfg FederationEntityConfig) (SchemaEntity, error) {
+	object, pkg := g.findOutputObjectByName(cfg.Object)
+	if object == nil {
+		return SchemaEntity{}, errors.New("output object not found")
+	}
+	if len(cfg.Keys) == 0 {
+		return SchemaEntity{}, errors.New("entity must have at least one key")
+	}
+	resolver, err := g.resolveObjectResolver(*object, cfg.Service, cfg.Method, cfg.DataLoader)
+	if err != nil {
+		return SchemaEntity{}, errors.Wrap(err, "failed to resolve entity reference resolver")
+	}
+	if !visible(object.Visibility, g.schemaCfg.Visibility) {
+		return SchemaEntity{}, errors.New("output object is hidden by schema visibility tags")
+	}
+	if err := g.validateEntityVisibility(*object, cfg.Keys); err != nil {
+		return SchemaEntity{}, err
+	}
+	if resolver.DataLoader != nil && strings.ContainsAny(cfg.Keys[0], " {") {
+		return SchemaEntity{}, errors.Errorf("data loader could resolve reference only by single field key, got '%s'", cfg.Keys[0])
+	}
+
+	return SchemaEntity{
+		Object:     *object,
+		Pkg:        pkg,
+		Keys:       cfg.Keys,
+		Service:    resolver.Service,
+		Method:     cfg.Method,
+		DataLoader: resolver.DataLoader,
 	}, nil
 }
 
+// validateEntityVisibility checks, that entity object keeps visible fields and key fields after visibility pruning.
+func (g *schemaParser) validateEntityVisibility(object OutputObject, keys []string) error {
+	tags := g.schemaCfg.Visibility
+	fieldsVisibility := make(map[string]bool)
+	for _, fields := range [][]ObjectField{object.Fields, object.MapFields} {
+		for _, field := range fields {
+			fieldsVisibility[field.Name] = visible(field.Visibility, tags)
+		}
+	}
+	for _, field := range object.DataLoaderFields {
+		fieldsVisibility[field.Name] = true
+	}
+
+	var hasVisible bool
+	for _, fieldVisible := range fieldsVisibility {
+		hasVisible = hasVisible || fieldVisible
+	}
+	if !hasVisible {
+		return errors.New("all output object fields are hidden by schema visibility tags")
+	}
+	for _, key := range keys {
+		for _, name := range keyTopFields(key) {
+			if fieldVisible, ok := fieldsVisibility[name]; ok && !fieldVisible {
+				return errors.Errorf("key '%s' field %s is hidden by schema visibility tags", key, name)
+			}
+		}
+	}
+
+	return nil
+}
+
+// keyTopFields returns names of top level fields of `@key` fields set, e.g. [id org] for "id org { id }".
+func keyTopFields(key string) []string {
+	var res []string
+	var depth int
+	for _, token := range strings.Fields(strings.NewReplacer("{", " { ", "}", " } ").Replace(key)) {
+		switch token {
+		case "{":
+			depth++
+		case "}":
+			depth--
+		default:
+			if depth == 0 {
+				res = append(res, token)
+			}
+		}
+	}
+
+	return res
+}
+
+func (g *schemaParser) resolveNodes() ([]SchemaNode, error) {
+	if g.schemaCfg.Relay == nil {
+		return nil, nil
+	}
+	if g.schemaCfg.Queries == nil {
+		return nil, errors.New("schema with relay nodes must have queries")
+	}
+
+	var res []SchemaNode
+	for _, path := range TypesFilesPaths(g.types) {
+		typesFile := g.types[path]
+		for _, object := range typesFile.OutputObjects {
+			if object.Node == nil || !visible(object.Visibility, g.schemaCfg.Visibility) {
+				continue
+			}
+			node, err := g.resolveNode(object, typesFile.Package)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to resolve node %s", object.GraphQLName)
+			}
+			res = append(res, node)
+		}
+	}
+
+	return res, nil
+}
+
+func (g *schemaParser) resolveNode(object OutputObject, pkg string) (SchemaNode, error) {
+	resolver, err := g.resolveObjectResolver(object, object.Node.Service, object.Node.Method, object.Node.DataLoader)
+	if err != nil {
+		return SchemaNode{}, errors.Wrap(err, "failed to resolve node resolver")
+	}
+	res := SchemaNode{
+		Object:     object,
+		Pkg:        pkg,
+		Service:    resolver.Service,
+		DataLoader: resolver.DataLoader,
+	}
+	if resolver.Method != nil {
+		res.Method = resolver.Method.Name
+		res.Argument = object.Node.Argument
+		if res.Argument == "" {
+			res.Argument = object.Node.KeyField
+		}
+		if !containsArgument(resolver.Method.Arguments, res.Argument) {
+			return SchemaNode{}, errors.Errorf("method '%s' argument '%s' not found", res.Method, res.Argument)
+		}
+	}
+
+	return res, nil
+}
+
+func (g *schemaParser) resolveComplexity(objects []*gqlObject) (*SchemaComplexity, error) {
+	cfg := g.schemaCfg.Complexity
+	if cfg == nil {
+		return nil, nil
+	}
+	if cfg.MaxDepth < 0 || cfg.MaxComplexity < 0 {
+		return nil, errors.New("limits must not be negative")
+	}
+	res := &SchemaComplexity{
+		MaxDepth:      cfg.MaxDepth,
+		MaxComplexity: cfg.MaxComplexity,
+		DefaultCost:   1,
+		Multipliers:   cfg.Multipliers,
+		Fields:        cfg.Fields,
+	}
+	if cfg.DefaultCost != nil {
+		res.DefaultCost = *cfg.DefaultCost
+	}
+
+	types := make(map[string]struct{})
+	for _, object := range objects {
+		types[object.Name] = struct{}{}
+	}
+	for _, typesFile := range g.types {
+		for _, object := range typesFile.OutputObjects {
+			types[object.GraphQLName] = struct{}{}
+		}
+	}
+	for key, field := range cfg.Fields {
+		parts := strings.Split(key, ".")
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return nil, errors.Errorf("field cost key '%s' must have `Type.field` format", key)
+		}
+		if _, ok := types[parts[0]]; !ok {
+			return nil, errors.Errorf("field cost key '%s' type not found", key)
+		}
+		if field.Cost < 0 {
+			return nil, errors.Errorf("field '%s' cost must not be negative", key)
+		}
+	}
+
+	return res, nil
+}
+
+// objectResolver is a service query method or data loader, which resolves output object by key.
+type objectResolver struct {
+	Service    *SchemaService
+	Method     *Method
+	DataLoader *EntityDataLoader
+}
+
+func (g *schemaParser) resolveObjectResolver(object OutputObject, serviceName, methodName, dataLoaderName string) (objectResolver, error) {
+	switch {
+	case serviceName != "" && dataLoaderName != "":
+		return objectResolver{}, errors.New("object must be resolved either by service method or by data loader")
+	case serviceName != "":
+		service, pkgName := g.findServiceByName(serviceName)
+		if service == nil {
+			return objectResolver{}, errors.Errorf("service '%s' not found", serviceName)
+		}
+		method := findMethod(service.QueryMethods, methodName)
+		if method == nil {
+			return objectResolver{}, errors.Errorf("service '%s' query method '%s' not found", serviceName, methodName)
+		}
+
+		return objectResolver{
+			Service: &SchemaService{
+				Name:         service.Name,
+				ClientGoType: service.CallInterface,
+				Pkg:          pkgName,
+			},
+			Method: method,
+		}, nil
+	case dataLoaderName != "":
+		loader, ok := g.dataLoaders[dataLoaderName]
+		if !ok {
+			return objectResolver{}, errors.Errorf("data loader '%s' not found. "+
+				"Only 1-1 data loaders without arguments and composite keys could resolve objects", dataLoaderName)
+		}
+		output := loader.OutputGoType
+		if output.Kind == reflect.Ptr {
+			output = *output.ElemType
+		}
+		if output.Pkg != object.GoType.Pkg || output.Name != object.GoType.Name {
+			return objectResolver{}, errors.Errorf("data loader '%s' loads values of other type", dataLoaderName)
+		}
+
+		return objectResolver{DataLoader: &loader}, nil
+	}
+
+	return objectResolver{}, errors.New("service method or data loader, which resolves object, must be set")
+}
+
+// objectResolverAuth returns policy of schema node, which owns entity or relay node resolver: policy of service query
+// method field, if schema exposes method, or queries policy otherwise, as `_entities` and `node` are queries fields.
+func (g *schemaParser) objectResolverAuth(objects []*gqlObject, service *SchemaService, method string) *AuthConfig {
+	if service != nil {
+		for _, object := range objects {
+			if !object.QueryObject {
+				continue
+			}
+			for _, field := range object.Fields {
+				if field.Service != nil && field.Service.Name == service.Name && field.Name == method {
+					return field.Auth
+				}
+			}
+		}
+	}
+
+	return g.schemaCfg.Queries.Auth
+}
+
+func (g *schemaParser) findOutputObjectByName(name string) (*OutputObject, string) {
+	for _, path := range TypesFilesPaths(g.types) {
+		typesFile := g.types[path]
+		for _, object := range typesFile.OutputObjects {
+			if object.GraphQLName == name {
+				return &object, typesFile.Package
+			}
+		}
+	}
+
+	return nil, ""
+}
+
+func containsService(services []SchemaService, name string) bool {
+	for _, service := range services {
+		if service.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+func findMethod(methods []Method, name string) *Method {
+	for i, method := range methods {
+		if method.Name == name {
+			return &methods[i]
+		}
+	}
+
+	return nil
+}
+
+func containsArgument(arguments []MethodArgument, name string) bool {
+	for _, argument := range arguments {
+		if argument.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
 func validateObjects(objects []*gqlObject) error {
 	objectNames := map[string]bool{}
 
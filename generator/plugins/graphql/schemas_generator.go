@@ -8,11 +8,11 @@ import (
 	"path/filepath"
 	"reflect"
 	"strings"
-	"text/template"
 
 	"github.com/pkg/errors"
 	"golang.org/x/tools/imports"
 
+	"github.com/EGT-Ukraine/go2gql/generator"
 	"github.com/EGT-Ukraine/go2gql/generator/plugins/graphql/lib/importer"
 )
 
@@ -22,6 +22,7 @@ type schemaGenerator struct {
 	goPkg         string
 	parser        *schemaParser
 	imports       *importer.Importer
+	generateCfg   *generator.GenerateConfig
 }
 
 func (g schemaGenerator) importFunc(importPath string) func() string {
@@ -45,6 +46,12 @@ func (g schemaGenerator) bodyTemplateContext() (interface{}, error) {
 		Objects:        schemaObjects.Objects,
 		Services:       schemaObjects.Services,
 		TracerEnabled:  g.tracerEnabled,
+		Federation:     g.schemaCfg.Federation != nil,
+		Entities:       schemaObjects.Entities,
+		Relay:          g.schemaCfg.Relay != nil,
+		Nodes:          schemaObjects.Nodes,
+		Complexity:     schemaObjects.Complexity,
+		Hidden:         schemaObjects.Hidden,
 	}, nil
 
 }
@@ -84,6 +91,23 @@ func (g schemaGenerator) bodyTemplateFuncs() map[string]interface{} {
 		"serviceConstructor": func(filedType string, service SchemaService, ctx SchemaBodyContext) string {
 			return ctx.Importer.Prefix(service.Pkg) + "Get" + service.Name + "Service" + filedType + "Methods"
 		},
+		"federationPkg": g.importFunc(FederationPkgPath),
+		"entityObject": func(entity SchemaEntity) string {
+			return g.imports.Prefix(entity.Pkg) + entity.Object.VariableName
+		},
+		"relayPkg":      g.importFunc(RelayPkgPath),
+		"complexityPkg": g.importFunc(ComplexityPkgPath),
+		"nodeObject": func(node SchemaNode) string {
+			return g.imports.Prefix(node.Pkg) + node.Object.VariableName
+		},
+		"loadersPkg": func(loader EntityDataLoader) string {
+			return g.imports.New(loader.LoadersPkg)
+		},
+		"authPolicy": func(cfg *AuthConfig) string {
+			return policyLiteral(g.imports.New(InterceptorsPkgPath), cfg)
+		},
+		"visibilityPkg": g.importFunc(VisibilityPkgPath),
+		"strings":       stringsLiteral,
 	}
 }
 
@@ -99,11 +123,7 @@ func (g schemaGenerator) headTemplateFuncs() map[string]interface{} {
 }
 func (g schemaGenerator) generateBody() ([]byte, error) {
 	buf := new(bytes.Buffer)
-	tmpl, err := templatesSchemas_bodyGohtmlBytes()
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to get head template")
-	}
-	bodyTpl, err := template.New("body").Funcs(g.bodyTemplateFuncs()).Parse(string(tmpl))
+	bodyTpl, err := g.generateCfg.ParseTemplate("schemas_body.gohtml", Asset, g.bodyTemplateFuncs())
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to parse template")
 	}
@@ -111,6 +131,9 @@ func (g schemaGenerator) generateBody() ([]byte, error) {
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to prepare body context")
 	}
+	if err := validateAuthTemplate(bodyTpl, schemaHasPolicies(bodyCtx.(SchemaBodyContext))); err != nil {
+		return nil, err
+	}
 	err = bodyTpl.Execute(buf, bodyCtx)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to execute template")
@@ -120,11 +143,7 @@ func (g schemaGenerator) generateBody() ([]byte, error) {
 
 func (g schemaGenerator) generateHead() ([]byte, error) {
 	buf := new(bytes.Buffer)
-	tmpl, err := templatesSchemas_headGohtmlBytes()
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to get head template")
-	}
-	bodyTpl, err := template.New("head").Funcs(g.headTemplateFuncs()).Parse(string(tmpl))
+	bodyTpl, err := g.generateCfg.ParseTemplate("schemas_head.gohtml", Asset, g.headTemplateFuncs())
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to parse template")
 	}
@@ -171,7 +190,7 @@ func (p *Plugin) generateSchemas() error {
 			return errors.Wrapf(err, "failed to resolve schema %s output go package", schema.Name)
 		}
 
-		parser := newSchemaParser(schema, p.files)
+		parser := newSchemaParser(schema, p.files, p.entityDataLoaders)
 
 		g := schemaGenerator{
 			parser:        parser,
@@ -181,20 +200,21 @@ func (p *Plugin) generateSchemas() error {
 			imports: &importer.Importer{
 				CurrentPackage: pkg,
 			},
+			generateCfg: p.generateCfg,
 		}
-		file, err := os.OpenFile(schema.OutputPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0666)
+		out := new(bytes.Buffer)
+		err = g.generate(out)
 		if err != nil {
-			return errors.Wrapf(err, "failed to open schema %s output file for write", schema.OutputPath)
-		}
-		err = g.generate(file)
-		if err != nil {
-			if cerr := file.Close(); cerr != nil {
-				err = errors.Wrap(err, cerr.Error())
-			}
 			return errors.Wrapf(err, "failed to generate types file %s", schema.OutputPath)
 		}
-		if file.Close(); err != nil {
-			return errors.Wrapf(err, "failed to close generated schema %s file", schema.OutputPath)
+		err = p.generateCfg.Output.WriteFile(generator.File{
+			Path:    schema.OutputPath,
+			Plugin:  PluginName,
+			Source:  p.generateCfg.Path,
+			Content: out.Bytes(),
+		})
+		if err != nil {
+			return errors.Wrapf(err, "failed to write generated schema %s file", schema.OutputPath)
 		}
 	}
 	return nil
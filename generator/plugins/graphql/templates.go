@@ -114,7 +114,7 @@ func templatesOutput_map_fieldsGohtml() (*asset, error) {
 	return a, nil
 }
 
-var _templatesSchemas_bodyGohtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x55\x4b\x6f\x1a\x31\x10\x3e\xef\xfe\x8a\xd1\x0a\xb5\x4b\x44\x16\xa9\x47\x24\x2e\xa5\x69\xda\x43\xf3\x68\xe8\xa9\xaa\x2a\x63\x86\xc5\x8d\xb1\x89\xd7\x9b\x34\xb2\xfc\xdf\x2b\x3f\xf6\x01\x04\x92\x5b\x39\x99\x99\xf1\x7c\xdf\xcc\x37\x9e\x35\xe6\x1c\xc6\x67\xa5\xd4\xcf\x5b\x9c\x40\xc9\xf4\xba\x5e\x14\x54\x6e\xc6\x17\x97\xf3\xf3\x1f\xf7\x8a\x30\x81\xe3\x52\x7e\x28\x1f\xf8\xb8\x44\x81\x8a\x68\xa9\xc6\x5b\x5e\x97\x4c\x54\xe3\x52\x91\xed\xfa\x81\x17\x77\x74\x8d\x1b\xf2\x51\x2e\x9f\x67\x52\x68\xfc\xab\xcf\xc6\x70\x6e\x6d\xea\xb2\x82\x31\x83\x18\x70\x45\x36\x68\x6d\x38\xcf\x38\x43\xa1\x2b\xa8\xb4\xaa\xa9\x06\x93\x26\xc6\x80\x22\xa2\x44\x18\x54\xa8\x1e\x19\x45\x98\x4c\x61\x50\xdc\x85\x3f\x95\x4f\x98\x24\xc6\x34\xee\x22\xa4\x0b\x89\xc0\x98\x52\xce\x1d\x5c\xeb\x0e\x8e\x4b\x6f\x75\x57\x8d\x01\x14\x4b\x9f\xc6\xa6\xe9\x5b\xd0\x56\xb5\xa0\x90\xd3\xd3\x15\x0c\xe1\x12\xf5\x11\x56\xf9\xf0\x55\x5e\xae\x72\x85\xba\x56\x02\x68\x71\x24\x4d\x6a\xd3\x1e\xf9\x40\x2b\x80\xbe\x40\x2b\xa7\xbc\x3a\xcd\x78\x04\x6c\x0d\x67\xc6\x30\xa1\x51\x51\xdc\x6a\xa9\xaa\x9b\xfb\xd2\xda\xe2\x6b\x67\xf9\x42\xc4\x92\xa3\x72\xf3\xc1\x56\x30\x28\xe6\x8a\x50\x54\x17\x82\x2c\x38\x7a\x1e\x23\xd0\x0a\x8c\x91\x5b\x14\x5a\x11\xca\x44\x19\x72\x84\x40\x77\x2f\x12\x1e\x42\x6e\x4c\xf9\xc0\x83\x3b\x30\x19\x01\x2a\x25\xd5\xf0\xed\xba\xb3\x15\x50\x5e\x1d\xeb\x10\x4c\xa7\x20\x18\x77\xe9\x92\xa6\x9d\x07\xa0\xc6\x8e\xc0\x18\x0f\x1c\xeb\xbd\x70\xe7\x55\x9e\x45\x2c\xa0\xcd\x2c\xed\x81\x00\x25\xe2\xbd\x86\x05\x7a\x10\xc1\x78\x36\x4c\x93\x64\x77\xa6\xde\x58\xc7\x23\x51\x87\xf9\x6f\x6b\x54\xcf\x9f\x19\xf2\x65\x05\x53\x30\x26\x7a\x67\x52\x84\xe7\x21\x15\x64\x3e\x26\xeb\x92\x0f\xac\xcd\x4f\x74\xc4\x89\x7c\x5a\xbc\xbe\x44\x91\xd8\x6f\x8f\x7e\x9c\xdc\x31\xfe\xdf\x6a\x4d\x34\x93\xe2\x95\x12\x9a\xb0\xff\x51\xc5\x2e\xc5\x63\xca\xc9\xc5\x1f\xa4\x3a\x08\x77\xed\xcf\x7b\xba\x85\x80\x66\x2c\xa6\xfd\x21\xbb\xc2\xa7\x70\xa5\x3f\xee\xc1\x32\x93\x62\xc5\x4a\x3f\x9c\xee\xe6\x04\xb2\xfd\x54\xd9\xc8\x39\x8d\x71\x95\x8a\x96\x48\x71\x5b\x4b\x8d\xcb\x99\xdc\x6c\xdc\x5c\x66\x59\x24\x93\x24\x9f\xb0\xa2\x8a\x6d\x5d\x45\x93\x1e\xaf\x9d\x78\x6b\x9b\xa4\x6d\xa5\x49\x12\xea\x9f\xf4\x89\x07\x93\x67\x17\x19\x70\x14\x2d\x85\xa8\x69\x03\xdc\x6b\xd6\x8a\x2f\x7d\xa7\x8e\x04\xc6\x5c\x2e\xac\x79\x04\x3d\x67\xe2\x5a\xe0\x5c\xb1\x7e\x5f\x45\x2f\x34\xda\xbb\xd2\xdc\xba\x0c\xb6\x00\xf4\x73\x2f\xc1\xaf\x51\x07\x8b\xbc\x3a\x8d\xf5\x6e\xbf\x7c\xd3\x84\xf6\x04\xea\xdd\x18\xb5\xee\xb9\xff\x50\x46\xef\x75\x5f\xc2\x2e\x66\x5f\x1c\x17\xfa\x92\x32\xfe\xf7\x1d\x2b\xc9\x1f\x71\x02\x6e\xad\xe7\xdb\xbe\x30\xd1\x75\x43\x14\xd9\x54\x43\xc8\xfd\xc2\x5e\x11\x8a\x6e\x93\x75\x0b\xb4\xf9\xc5\xbd\x17\xde\x9b\xb1\x2e\x48\x30\xde\xfa\x3b\x4c\xdb\x6f\x55\x37\x1a\x07\xff\x0f\x3a\x99\x09\x19\x9a\x7f\xaa\x85\xb1\x81\x6d\x68\x03\xd6\x74\xae\xdb\xca\x5a\x31\x51\x46\x77\xe4\xb4\xcb\xc0\x1b\xdd\xbb\xee\x9b\x0f\xd7\xfb\x15\x3e\xc5\x6f\xdf\xc1\xce\xef\x5e\x9e\x5f\x63\x5e\x8e\xc2\x1f\x83\x76\x41\x89\x38\xa9\x45\xb3\x24\x82\xaf\x61\xd1\x58\xc3\xe5\xdd\x98\xf6\x7e\x4b\xcf\x0e\x53\xfb\x2f\x00\x00\xff\xff\xb8\x44\x15\x75\x56\x09\x00\x00")
+var _templatesSchemas_bodyGohtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x59\x59\x6f\xdb\x46\x10\x7e\x96\x7f\xc5\x56\x70\x5c\xca\x50\xa8\xa2\x8f\x2a\xfc\x90\xda\xce\x81\xe6\x70\x12\x37\x79\x08\x82\x80\x26\x97\xd2\xd6\x14\x29\x2f\x49\xc7\x2a\xa1\xff\xde\x39\x96\xcb\xe5\x21\xd9\x49\x53\xd4\x40\x10\x71\x67\x76\xe6\x9b\x63\xbf\x59\x4a\x55\xf5\x58\xcc\x8e\x17\x59\xb1\x59\xcb\xb9\x58\xa8\x62\x59\x5e\xf9\x61\xb6\x9a\x9d\x3f\xbb\x7c\xfc\xe7\xb5\x0e\x54\x2a\x67\x8b\xec\xd7\xc5\x4d\x32\x5b\xc8\x54\xea\xa0\xc8\xf4\x6c\x9d\x94\x0b\x95\xe6\xb3\x85\x0e\xd6\xcb\x9b\xc4\x7f\x1f\x2e\xe5\x2a\xf8\x3d\x8b\x36\xa7\x59\x5a\xc8\xbb\xe2\x78\x26\x1e\x6f\xb7\x07\x68\x55\x54\xd5\xa1\x51\x78\x1d\xac\xe4\x76\xcb\x9f\x4f\x13\x25\xd3\x22\x17\x79\xa1\xcb\xb0\x10\xd5\xc1\xa8\xaa\x84\x0e\xd2\x85\x14\x87\xb9\xd4\xb7\x2a\x94\x62\x7e\x22\x60\x2b\x3f\xe4\x64\x70\x04\x5a\xb5\xd8\x67\x73\x6c\x08\xbc\x2c\xb2\x4b\x74\x67\xc5\x2c\x78\x46\xab\xb8\x15\xec\xcb\x34\x22\x33\xdb\x83\x83\x87\x78\x8b\xcb\x34\x14\x5e\xb8\x3f\x82\x89\x78\x26\x8b\x1d\xa8\xbc\xc9\xbd\xb8\x30\x72\x2d\x8b\x52\xa7\x22\xf4\x77\x98\x01\xbc\x0e\x78\x86\xc5\x4e\x07\x60\x79\x61\x92\xef\x47\x3c\x15\x6a\x29\x8e\xab\x4a\x41\xa9\x74\x28\xd7\x50\xd1\xfc\xe2\x7a\xb1\xdd\xfa\x2f\x9a\x95\xe7\x41\x1a\x25\x52\x57\xd0\x1f\x2a\x86\xc4\x5c\xea\x20\x94\xfa\x3c\x0d\xae\x12\x49\x38\xa6\xa2\xd0\xe0\x27\x5b\x83\x49\x90\xa9\x74\xc1\x36\x58\x11\xf7\x19\xc0\x13\xe1\x41\x12\x6e\x12\x16\x33\x92\xa9\x90\x5a\x67\x7a\xf2\xf0\xba\x03\x08\x08\x6c\x57\x86\xc4\xc9\x89\x48\x55\x82\xe6\x46\x75\x3a\x7b\x4e\x2b\xc0\x5c\x55\xe4\xd8\xc4\x7b\x8e\x9f\x63\x6f\x6c\x7c\x81\x07\xd3\x4b\x1d\x27\x22\x0c\xd2\x9f\x0b\x71\x25\xc9\x09\xfc\x1b\x4f\xc0\x51\xbb\xa7\x1e\x18\xc7\x6d\xa0\xfb\xf6\xdf\x96\x52\x6f\x9e\x2a\x99\x44\xb9\x38\x01\xb1\x91\xc2\x61\xe2\xe3\x91\x69\x31\x26\x9d\x71\x63\xfc\x70\xbb\xf5\xf6\x64\x04\x8b\xbc\xbf\x78\x6e\x89\x0c\xb0\x2f\xe4\x7d\x37\xb8\x5d\xf8\x5f\x95\x45\x50\xa8\x2c\xbd\x27\x84\x5a\xed\xff\x88\xa2\x0d\x71\x57\xe5\xb2\xab\xbf\x24\xb0\x11\x15\xee\x0d\x7d\xee\xd4\x8d\x15\xea\xb6\x38\x71\x9b\xec\xb5\xfc\xca\x5b\xdc\x76\xe7\x15\xc8\x42\xac\x16\xd4\x9c\xb8\x73\x2e\xc6\x5d\x53\xe3\x29\x0a\x01\x08\x44\x9a\x5a\x20\xfe\xdb\x32\x2b\x64\x74\x9a\xad\x56\xd8\x97\xe3\xb1\x01\x33\x1a\x9d\xc9\x3c\xd4\x6a\x8d\x11\xcd\x1d\x5c\x2d\x7d\x48\x90\x31\x6a\x23\x1d\x8d\x38\xfe\xb9\x0b\x9c\x97\x08\x9d\x41\x90\xc8\xd4\x42\x30\x35\xad\x1d\x3b\xc9\x8a\x93\x88\x32\xb5\x43\xd1\xd8\x42\xb5\xfa\x10\x38\x42\x57\xfa\xa4\x2c\x96\xae\x68\x84\xe9\x41\x81\xc9\xcd\x1c\xba\x80\x94\x32\xad\xfe\x96\xe4\xc7\xab\x55\xde\xb7\xaa\x3c\x9e\x8a\xce\x5e\x3c\xf2\x01\x6c\xbd\xc8\x12\x15\x6e\x1a\x7f\x5b\x22\x83\x01\x13\x4d\x32\x91\xa0\x79\x8d\x43\xfb\xd4\x31\xfd\x79\x32\x75\x82\x91\x49\x2e\xf7\x07\xf1\x6f\xdd\xb5\xbc\x35\x15\x1d\x76\xdf\xf3\x7e\xd4\xad\x78\x65\x91\x36\x3d\xe9\x26\xce\x8a\x2f\xe9\x6e\x60\xa4\x6f\xdc\xae\x6d\x74\xba\xfd\x88\xaa\x43\xcd\x48\x7f\xef\x64\x9e\x25\xb7\x60\x13\x27\x99\xb7\x76\x7b\xd1\x88\x2e\x02\x1d\xac\x60\xb4\x7a\x34\xa3\x62\x38\xf7\x48\xde\xcd\xcc\xa8\xff\x0c\xd5\x33\xc5\x54\x5b\x54\x02\x76\xb6\xf2\xc6\xa7\xfd\xd4\xcd\x5d\xe7\xb9\x97\xc9\x71\x9a\x71\x39\xf6\xa5\xd0\x24\xd0\xaa\xd6\xce\xea\xcc\x35\x83\xa8\xd0\x30\x28\x8d\xd8\x60\x6a\x23\xa0\x45\xa4\x32\x77\x39\xe7\xf1\x1d\x2f\xf0\xbc\xf5\xe6\x5a\xc3\x2e\x44\xd5\x94\x7f\x9f\x3e\x72\xb1\x38\xf5\xe6\xbc\xf9\x35\x11\xb2\xac\x76\x5b\xaf\xf2\xe6\xb6\x8e\xdd\x6f\xf1\x30\x67\x92\xb9\x77\x32\x09\x36\xbc\x0a\xe5\x61\x7c\x1a\xd7\x18\xe1\x93\x28\x7a\x9d\x45\xe6\xcc\x1e\xd9\x38\xa6\x6d\xad\x26\x02\x54\x06\x76\xfa\xf4\xb9\x25\xc7\xd5\xea\xa0\x45\x3e\x29\x2c\x31\x4f\xd3\x96\x3a\x0e\xae\x08\xe3\xc6\x58\x50\xcd\x44\x4a\x5b\xb6\x4d\xd2\x01\x3e\xdc\x71\x78\xd9\x12\x14\x3f\xb5\x08\xc9\x76\x6b\x0b\x12\x45\x84\xae\x8d\x58\x7b\xc3\x1c\xe5\x5a\x6f\x93\x14\x49\x5e\x49\xd8\x11\x0d\xf0\x94\xc5\x61\x88\x6a\xc0\x8e\x33\x98\x3f\xf5\x0d\x02\x3b\x35\x6e\x9e\xe8\x45\xc9\xa7\x70\x3c\x99\xb6\x1a\x1d\x8b\xd8\xca\xc0\xb7\x86\xfd\x5d\xd8\x1e\x04\xad\x87\xe4\x1e\xba\x80\xcb\x42\x84\x54\x00\x27\x6c\x3f\x73\x98\xde\xed\x97\x1a\x6f\x99\xa6\x8b\xdd\x6a\x7a\x47\x43\x97\x65\xf3\xaa\x53\x99\xc0\xe7\xf5\x15\x6d\x2a\x38\x4e\x22\x84\x48\xc2\x33\xc3\x9b\x8b\xf5\x76\x4f\x95\x27\xbf\x91\xeb\x9f\x9c\x8b\x6c\x43\x70\xb0\x44\x41\x18\xe2\x18\xa4\x2e\xba\xa2\x5c\xcb\x8d\xf3\xca\x41\xe6\xcf\x82\x22\x78\x99\x05\x91\xd4\xfe\x1f\x72\xd3\xbc\x0f\xb5\xe3\x6d\x55\x19\x00\xe7\x12\x94\x3d\x15\x4d\xc5\x11\xd8\xbc\x1f\x5c\xfb\x62\xfd\x11\x5e\x0c\x63\x0f\x56\xa0\xd0\x2a\xbd\x0d\x12\xa8\x0c\x42\x7b\x04\xdc\x08\x55\x9a\x74\xe3\x28\xe4\x6a\x9d\x04\x85\x14\xe3\x04\x90\x5e\x2e\xcb\xf4\x7a\xdc\x83\x6f\x23\xdd\x4d\x9c\xed\x35\x5c\x42\x2a\x35\x51\x3a\xe8\xf7\xbd\x22\x50\x9a\xfb\x77\xfb\xaf\xf0\x6e\x2c\x0e\x97\x2a\x8a\xe0\x72\x44\xc4\xf3\x9c\x3f\x93\xc2\x5a\x97\xf0\x72\xcc\x89\xbc\x55\xb9\xba\x52\x89\x2a\x36\xf6\x62\x78\x41\x62\xaf\x2f\x63\x1b\x08\x09\xcb\x42\xf7\x32\xee\xe0\xbc\xf6\x45\xf7\x82\x9c\x79\xab\xb9\xbc\x75\x95\x58\xc2\x5a\xf5\x89\x1a\x54\xb4\x42\xd6\x3d\x4f\xcb\xd5\x87\x20\x29\x87\x5d\x37\xd2\x6d\x27\x97\x10\x28\x87\xec\x53\x68\x0e\xb5\xf7\x5b\xe5\x3b\x92\x4d\xa3\xe5\xa9\x8c\xf0\xfb\x06\x98\x43\xbc\x6e\xed\xc4\x56\x60\x13\x6c\xde\x7d\x5b\x03\xa6\xab\xd6\x4c\x99\xf3\xb4\x50\x85\xaa\x07\x4d\x57\x8f\xa4\x9b\xce\xbc\x91\xb4\xc8\x85\xaf\xb7\xef\x1a\x3a\x0c\xdf\xb4\xc7\x76\x6b\x12\x65\x5f\x0e\xd8\x54\x3d\x95\xf8\x09\xce\x7f\x4d\x7b\x78\x27\x1c\x56\x31\x49\xaa\x67\x18\x1c\x50\x8a\x80\xab\x56\x09\x7c\x15\x32\x68\xf1\xac\x21\x54\xde\x89\xe7\x3e\xc7\xed\x6b\x50\x2c\x62\x31\x7e\x74\x33\x26\x1d\x9e\x2d\x26\xf1\xc2\x98\x7d\x91\x63\xc7\xbd\x89\x0d\xdf\xde\x62\xfd\x85\xc3\xa6\x13\x71\x95\x65\x96\x04\xbe\x4c\x45\x76\x8d\xbe\x48\xcf\xf7\x8e\x1b\xf6\x31\xce\xcd\x8d\xb1\x26\x1e\x73\xf6\x4d\x31\xb3\xeb\xce\x89\xae\xa7\xb2\xd9\x6c\xe7\xb2\x79\x1e\x9a\xcc\xef\x64\x2c\xb5\x4c\x43\x9a\x55\xdd\x62\xd2\xc1\xb0\x1a\xf7\x0c\xeb\xb6\xd3\xf6\xb8\x36\xb2\x9d\x03\xdb\x01\x68\x46\xf6\xa0\xb5\xee\x60\xec\x9a\xfd\x3c\x19\x18\xd0\x9d\x64\xfc\x80\xf8\xbf\x1f\xde\xde\x19\xed\x40\xb9\x77\x58\x6b\xb9\xd6\x32\x07\x07\x7c\xc4\x57\xc1\xfa\x13\x77\xf2\xe7\x56\xb3\x3d\x60\x90\x0f\xf5\xc6\x8f\x1e\xe5\x5f\xa4\x39\xf4\x7b\xe7\x79\xab\x09\xfe\x8b\x89\x6e\x1c\x3c\x74\xa6\x77\xbb\xc1\xd6\x07\x87\x7b\xbb\x00\x18\x8a\xcb\x0f\x90\xf7\x48\xde\xb5\x28\x44\xfc\x32\xc1\xd6\x7e\xd8\x85\x60\x20\xb6\xe1\x29\xdf\x0b\xe9\xbb\xe7\x7c\xab\x29\xfb\x53\x67\x60\x4c\x4c\x3a\x5f\x0b\xdb\x39\x1f\x66\x00\x55\xde\x59\xca\x3f\x6d\x9e\x41\xd1\x7c\x19\x34\xf4\x15\x6b\xa3\x87\xf9\x6f\xcc\x74\x46\xd0\xab\xe0\xee\x0c\x3a\x70\x49\xef\x5b\x8d\x96\x5f\xaf\x13\xc7\xc3\x43\x63\x6f\x40\xb3\x11\x92\xfa\x99\x8c\x83\x32\x29\x4e\xb3\xbc\xe8\x2a\x3b\x22\x52\x35\x07\xc7\x35\x07\x62\xb5\x4e\x94\xd4\x66\xac\x39\x0b\x3b\x86\xcc\xca\x6a\x50\x8e\x86\x8d\xf5\xc6\x4e\xb3\xab\x3f\x7d\xdc\xaa\xd6\x77\x1d\x87\x17\xfa\xf9\x24\x25\x8c\xaa\x3a\x70\x87\x35\x34\xe8\x54\x1c\xc6\x28\xec\x42\x6b\x7d\x4b\x35\x34\x11\xe7\xdc\xcd\x36\x8b\x64\xc5\xb7\x99\x6b\xbe\xb8\xa2\xf5\x5e\xda\x46\xdf\x9e\xb8\x9e\xa5\x6f\xca\xd9\xc0\x59\x68\xbf\xab\x4f\xf1\xe7\x8e\xd9\x4c\x7c\xc0\x5b\x38\x9c\xbe\x6e\xe3\x12\xd5\x01\x1b\xf3\xb7\x9d\x37\xf8\x34\x15\x5f\x97\x2a\x5c\x0a\x79\x17\x4a\x19\xf5\x7f\x4e\x10\x7c\x80\x44\x84\xad\x2a\x32\x2d\x9c\xe3\x92\xa8\x95\xc2\xdf\x18\xae\x64\x9c\x69\x09\x26\x64\x58\x22\xbf\xf8\x88\xe1\x1d\x1d\x4a\x19\x89\x35\x51\x28\xec\x23\xe2\xa5\xff\x03\x95\xe6\x64\xa8\x2c\x40\x81\x70\xc0\x63\x5e\xd4\x60\xc2\xac\x84\x72\x5e\x49\x51\x04\xd7\x70\xaf\xa2\x73\xea\x16\x56\x67\x2b\xc3\xe3\x3e\xff\x44\xb2\x37\x60\xcf\x20\x70\xf8\xc1\x7e\xe9\xd4\x5b\x73\xe7\x8e\xe5\x95\x6e\x2f\xd6\xee\x8c\xe5\xe9\xbd\x0c\x31\x69\xff\xae\x83\x0d\x12\xc9\x58\xa5\x6d\x7e\xa4\x22\x46\x96\x1c\x73\x26\xf6\x84\x1f\xc0\xb3\xf0\xc1\xf7\x33\x59\x34\xfc\x99\x3b\xa9\xf0\xd6\xf5\x70\xe3\xbb\xbb\x6b\xe8\xa4\x77\x45\x1f\x78\x93\x03\xd2\xf4\xc6\x68\x5b\x18\x97\x22\xcd\x0a\x11\x67\x25\xa0\x56\x69\x5d\x40\x5f\x9c\x06\x49\x52\xab\x20\x1c\xe3\xf5\x23\x54\xc9\xf8\xc3\xdf\x4c\x20\x14\xd6\xc1\x28\x1c\x2c\x3e\xfd\x9c\x65\xee\x20\xbc\xe6\x81\x7a\x81\x29\x40\x55\xde\xe4\xbf\xac\xd3\xe2\xe1\x08\x3a\xb0\xc5\xa0\xbb\xc6\x9e\xab\x82\x51\x23\x73\x68\xd7\x7c\x4d\xe8\xfc\x76\xf0\x0f\x00\x00\x00\xff\xff\x03\x00\x89\x35\xc0\x0a\x08\x1d\x00\x00")
 
 func templatesSchemas_bodyGohtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -129,7 +129,7 @@ func templatesSchemas_bodyGohtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/schemas_body.gohtml", size: 2390, mode: os.FileMode(420), modTime: time.Unix(1548949366, 0)}
+	info := bindataFileInfo{name: "templates/schemas_body.gohtml", size: 7432, mode: os.FileMode(420), modTime: time.Unix(1792410390, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -154,7 +154,7 @@ func templatesSchemas_headGohtml() (*asset, error) {
 	return a, nil
 }
 
-var _templatesTypes_bodyGohtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x59\x5b\x6f\xdb\xb8\x12\x7e\x96\x7e\xc5\x1c\x9d\x9c\x40\x2e\x54\xf9\xe0\x3c\xfa\xc0\x0f\xd9\x6e\x53\x14\xdd\x36\x6d\xb7\xdb\x3e\xa4\x46\xc2\xda\xb4\xc2\x95\x4c\x29\xd4\xa5\x09\x08\xfd\xf7\x05\x6f\x12\x75\xb1\xad\x74\xd3\xc5\x62\x51\x3d\x04\x16\x35\x9c\x19\x7e\xf3\xcd\x90\x9c\x70\xfe\x14\xe6\x4f\xa2\xb4\xb8\xcf\xf0\x02\x22\x52\xdc\x94\x5f\xc2\x75\xba\x9b\x3f\x7f\xf1\xe1\xe9\x6f\x31\x43\x84\xe2\x79\x94\xfe\x2f\xba\x4d\xe6\x11\xa6\x98\xa1\x22\x65\xf3\x2c\x29\x23\x42\xf3\x79\xc4\x50\x76\x73\x9b\x84\x3f\xa5\x9b\xfb\x67\x29\x2d\xf0\x5d\xf1\x64\x0e\x4f\xeb\xda\x9d\xcf\xe1\x39\x2d\x77\xb9\xcb\x39\x43\x34\xc2\x70\x82\x69\xb9\x83\xc5\x12\xc2\x73\x92\xe0\x50\x7e\x94\x92\x4e\x85\x18\x70\x2e\xbf\x87\x1f\x11\x23\xe8\x4b\x82\xdf\xa0\x1d\xae\x6b\x58\x02\xe7\xd1\x6d\xf2\x36\x8e\xea\x3a\x7c\x83\xbf\x8a\x59\xbe\x35\x24\xde\x9f\xa5\x74\x4b\x22\xee\x3a\x8e\x98\xb4\x00\xfd\x78\x46\xe5\x0b\xe1\xe3\xbb\x5f\x94\x46\x2f\x70\x1d\x87\x73\x20\x5b\xe5\x50\xf8\x2c\xdd\xed\x30\x2d\x94\x27\x8e\xf3\x33\xce\xd7\x8c\x64\x05\x49\xe9\xa2\x71\x4a\xcb\xd4\xb5\x9e\x8c\xe9\x46\xcb\x7f\x44\x49\x89\xf3\x05\xf4\x5c\x92\xc3\xca\xaf\xd7\x28\xe3\x2e\x58\x4f\x83\x47\x25\x84\x04\x20\x66\xe5\x42\x95\xf1\xc3\x11\xee\x4b\x89\x50\x3b\xbe\x80\xd3\xfd\x56\xb8\x9c\xa3\xdc\x91\x7e\xab\xa9\xf2\x5d\xb9\xed\x98\x65\x53\x63\xba\x59\xfa\xb5\xe7\x5d\x37\x76\x87\x10\x74\x84\x6d\x65\x2d\x0c\x8e\xa3\x86\x39\x6f\xc7\xc4\x48\x3d\x73\xdb\x21\x57\x70\xe2\x25\xcd\xca\x02\xd2\x2f\xbf\xe3\x75\xe1\x72\x0e\x1a\x0b\x35\xd0\xb2\x43\x8a\x5d\xc8\xc1\x2e\x49\x94\xe0\x31\x9a\x58\xd3\x6d\xb6\x58\xc3\x06\x36\x13\x15\x45\x1d\xaf\xb5\xd0\x67\x8d\x11\x3c\x27\x38\xd9\x74\x23\x3e\x50\x2b\x65\x44\xe0\x6b\x35\xaf\x9e\xb9\xce\xb6\xa4\x6b\x20\x94\x14\xfe\x8c\x4b\x16\xe9\x95\x6f\x85\xac\x64\x81\x36\xac\x0c\x18\x60\xf7\x2d\x39\x3c\xdb\x6c\xa4\xa4\xb2\xe8\x0b\xcf\xa5\x2a\x43\x97\xa0\x43\x17\xcb\x45\x6b\x16\xff\x20\x93\x9e\xf3\x35\x4a\x12\xed\x4a\x28\xc6\xe0\xa4\xae\x03\xe8\x13\x41\x7d\x7f\x57\xa6\x05\xde\x34\x74\x10\x6b\xb3\xe3\x5e\xbb\x16\x33\xfa\x11\xcf\x81\xe1\x3c\x4d\x2a\xcc\x72\x2b\xf8\x66\x6c\x34\xfc\xef\xcd\x04\xa9\x50\xa2\xc8\x79\x33\x25\x3c\x2f\xe9\x5a\x38\xa8\x56\xed\xaf\x8b\x3b\xb1\x9c\xe2\x4e\x2d\x5b\x97\xa4\x00\x08\x10\x5a\x60\xb6\x45\x6b\xcc\xeb\x19\xf8\x57\x22\x7e\xa9\x5a\x6a\xa3\xeb\xa2\x2c\xb2\xb2\x78\x21\x87\xc5\xfa\x19\x66\x0c\x30\x63\x29\x13\x21\x23\x5b\x20\xb0\x5c\x02\x25\x09\x88\x08\x32\x5c\x94\x8c\x8a\xd7\x40\xfc\x11\x2b\x77\x10\x8b\xf2\x00\xae\xc4\x42\x48\xe8\xef\x50\x76\x99\x17\x8c\xd0\x68\x65\x5b\x77\x9d\x2b\x58\x82\x10\x55\xac\x66\x38\x2f\x93\x02\x96\x40\xf1\x57\xdf\xb8\x75\x9e\xb2\x37\xf8\xeb\x5e\xe7\x66\xae\xd3\xe2\xd7\x52\xa8\x85\xc5\x26\x91\x2e\x77\x2a\x7c\x2f\xf3\x73\x96\xee\xce\x58\xd4\x50\x8c\x6c\xa5\x33\x97\x2d\x85\x34\xf7\x65\x14\xa4\x26\xcd\xa9\x15\xfc\xab\x5d\x7f\xb7\x00\x28\x1b\x24\x3f\x63\x0c\xdd\x1b\x5b\xca\xdb\xa6\x46\x10\x2a\x9c\x9c\x6a\x2b\xf4\x2f\x7b\xb0\x39\x02\x74\x81\x55\xd8\xcc\x56\xa8\x58\xf3\x60\x09\x3b\x14\x63\xbf\x0d\xaf\xed\x8a\x88\x6a\x82\xa9\x4f\xe8\x4c\xe9\xdb\xa6\x0c\x48\x00\x15\x4a\x84\x6b\x0a\x4f\x42\x81\xdb\x05\x53\x6b\x30\x44\xfc\x44\x8a\x9b\xe7\x82\x14\xd0\x54\x4c\x2d\xee\x38\x55\x20\xf8\x22\x54\x75\x73\x4a\xd6\x61\xa3\x00\xbc\x0a\x25\x9e\x48\x31\x33\x8d\x6c\xe5\x34\x0b\x5c\xf5\xd8\x14\xe3\x5c\x32\x31\x57\xc4\xfe\xc4\x50\xe6\x63\xc6\x02\xf0\xb6\x88\x24\x78\x03\x45\x6a\x72\x0b\xae\xf7\x27\xc8\x35\x10\x2b\x1d\x41\x7a\xe7\xcd\x8c\xc1\xc6\xa1\xa3\x30\x5f\x92\x15\x2c\xa1\x72\x3b\xf3\x04\x23\x92\x1c\x5b\x5b\xc9\x44\x3d\x0f\x00\xab\xbf\xed\xb8\x23\x76\x8f\x84\xad\x71\xcf\x00\x3d\x2d\x6a\xfe\x3a\xa5\x6b\x54\x80\x27\x09\xfc\xd9\xf3\xe0\x10\x83\xc1\xfb\xec\xad\xbc\x99\x15\xe5\x3d\x41\xfe\x8b\x63\x3c\x39\x32\x6d\x78\x1b\x50\xbb\xc1\x9d\xa2\xe1\xfb\xe1\x39\xa8\x3e\xed\xdb\x91\x72\x57\xf7\x6a\x57\xf7\xb7\x39\x8f\x50\x9c\x6e\xbb\x25\xf5\x82\xe2\x8b\x6d\xaf\xae\x6a\x69\x42\x37\xf8\x2e\xe8\xec\xe5\x62\xfe\x60\x2b\x17\x14\xb8\xd5\xe2\xf0\x5f\xab\xfe\x1e\xab\x86\x57\x01\xa4\xf1\x43\x8a\xe7\xff\x85\xfc\xe9\xe9\x71\xc5\x2d\x1f\xa1\xf7\x4c\xc8\xa2\xfe\x14\xf1\x3c\x2c\x99\x0e\x87\xfc\x4a\x07\x7c\xcc\x4e\x3f\x9d\xc6\x64\xc4\xf3\x8d\xf9\x95\x8a\x68\x77\x52\xe8\x28\x96\xde\x6c\xd4\x89\xa1\xfb\x76\x2a\x8d\x42\xf8\x7d\xd1\x6b\x0f\x6b\x07\x43\x7e\x96\xe7\x24\xa2\x84\x46\x02\xa7\x0c\xef\x8f\x78\x5b\x08\x14\xeb\x8f\x17\x82\x81\x6a\xaf\xf2\xf6\xb8\x7a\x18\xa9\x29\xa6\xab\x51\xad\x6d\xed\xa8\x39\x37\x36\x94\xb1\x1f\xf9\xf8\x23\x1f\xbb\x10\xfe\xc8\xc7\x89\x48\x3d\x56\x3e\x9a\xeb\xa3\xbe\x59\xaa\xad\x59\xfd\x70\xcd\x89\x49\xd9\x52\x77\xaf\xce\xa5\x73\x3e\x07\x65\xd7\x5c\x3a\x47\xfb\x0c\x27\xea\xa6\xa9\x24\xbf\xbd\xd3\x30\x6c\x32\x74\xfb\x0b\xa6\x29\x75\xa0\xb3\xe0\x38\x23\x3d\x05\x35\xc4\x75\x13\x65\xd8\x3d\x68\x16\xd4\x22\xfc\x1e\xd3\x0d\x66\xea\x1a\x7d\x62\x43\x6f\x3e\x74\x9a\x0a\xc3\xcf\xa1\xfa\xa1\x0f\x2d\x06\xaa\x93\xba\x7f\x60\xea\x83\xfd\x1a\x65\x79\xe7\xc8\x39\x0e\xb8\xc2\xfb\x35\xca\xfe\x19\xbd\x9d\x0f\x37\x25\x8d\x7d\x11\x17\x7f\x36\x69\x42\xaf\x26\x6a\x16\x4f\x6a\x23\xa9\x86\x60\x8c\xef\x7b\x4d\xc0\x49\x5d\x1d\xbd\xe6\x57\xf8\x5e\x49\x9a\xfe\x8e\xee\x4d\x39\xc6\x21\x4f\xf6\xf9\xfe\x84\x05\x59\x13\x47\x6d\x98\xa7\x2d\x1a\xf5\xac\xe9\x8c\x1d\x23\xd3\xc4\x96\x51\x97\x58\xdd\xae\x91\xf3\x78\x6d\xa3\x1d\xca\x2e\x47\x5a\x47\xaf\xf0\xbd\xe9\x30\xac\x46\x3e\x4b\x68\xf6\x35\x96\x06\x9d\xa5\x61\x6b\x49\x5e\x5a\x54\xfb\x84\x8c\xf4\x45\x74\x07\x69\xa1\x7b\x1f\x8f\xe0\xa3\xd0\xaa\x7b\x23\x64\xb4\x39\x72\x05\x4b\x20\xe2\x87\xfe\x2a\xa4\x0e\x74\xbc\x1c\x27\x0e\xd4\x3e\x5a\xa1\xe4\x52\x52\x79\x15\xa8\xdf\x8a\x76\x2b\xa9\x34\x00\xa1\x37\x0e\xaa\xe6\xbe\xd4\x71\x7f\xff\x5d\x3e\x8e\x07\xc7\x9c\xb1\x89\xe0\xc5\x6d\x2f\x61\xf4\x42\x7e\xf8\x78\xb2\xdd\x7b\x3e\xf9\xf7\x7f\x36\x82\x1b\x80\x13\x2c\x7b\xea\x62\x85\x01\x90\x99\xdd\xa5\xe8\xdc\x9f\xe3\xf8\x21\xce\xda\x8d\xf5\x3e\x30\x9d\xb3\xc8\x10\x9a\x6a\x78\x02\x1c\x9f\xda\xec\xfa\xdf\x1d\x1c\x15\xf2\x83\xf0\x54\xd5\xc3\x1c\xee\x00\xa4\xf2\xe1\x32\x8e\x57\xcb\xaa\xd2\xd9\x33\x72\x6a\x18\xf6\xaa\x65\xf5\x49\x8f\x1f\x1e\x9a\x92\xf3\x37\x38\x3e\xe8\x8a\x7a\xe0\x10\xd1\xfe\x33\x66\x78\x8e\x98\xf8\xdf\x05\x45\xe7\xd3\xbe\x6e\xc9\x0b\xed\xa1\x14\x11\xef\xd3\xb6\x1f\x29\xaa\x23\xb9\x00\xb9\x8f\x66\xb6\xef\xfa\xd3\x5b\xc4\xd0\x2e\x9f\x81\x6f\xd5\x93\x40\xd7\x4e\x4d\xcb\x9c\xad\x45\x4c\xb2\xf0\xd7\xb4\x64\x6b\x7c\xb0\x0a\x09\x5e\x0b\xf9\xe5\x5e\x5e\xab\x7a\x6b\xba\x5e\xfa\x4b\xce\xd6\x4d\xd1\xd2\x02\x72\x01\xfa\x9f\x1f\x13\x41\x34\xb4\x3f\x08\xa3\x16\xda\x0b\xe4\xc8\x2e\xfb\x78\x50\x8e\xd9\x6a\xb3\x2d\x6b\xb2\xad\x59\x7c\x6d\xfd\x7f\xef\x8f\x00\x00\x00\xff\xff\x41\x21\xf3\x04\x4a\x1e\x00\x00")
+var _templatesTypes_bodyGohtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x59\xeb\x6f\xdb\x46\x0c\xff\x2c\xff\x15\x37\x2d\x0b\xe4\x40\x95\x87\x7d\xf4\x90\x0f\x59\xd7\x04\xc1\xd6\xa6\xed\xfa\xf8\x90\x1a\x89\x22\x9f\x9d\x9b\x65\xc9\x3d\xc9\x4e\x02\x41\xff\xfb\xc8\x7b\x48\x77\x7a\xd8\x4e\x5f\x1b\x86\x1a\x68\x11\xdd\xf1\x48\x1e\xf9\x23\x45\x52\x45\xf1\x84\x8c\x8e\xe6\x69\xfe\xb0\xa2\x63\x32\x67\xf9\xed\xfa\x26\x88\xd2\xe5\xe8\xd9\xd9\x9b\x27\x6f\x17\x3c\x64\x09\x1d\xcd\xd3\x5f\xe6\x1f\xe3\xd1\x9c\x26\x94\x87\x79\xca\x47\xab\x78\x3d\x67\x49\x36\x9a\xf3\x70\x75\xfb\x31\x0e\x7e\x4b\xa7\x0f\x4f\xd3\x24\xa7\xf7\xf9\xd1\x88\x3c\x29\xcb\xc1\x68\x44\x9e\x25\xeb\x65\x36\x28\x0a\x1e\x26\x73\x4a\x0e\x28\x3c\x92\xf1\x31\x09\x4e\x59\x4c\x03\xb1\x29\x28\x9d\x4d\xc8\x49\x51\x88\xfd\xe0\x5d\xc8\x59\x78\x13\xd3\x17\xe1\x92\x96\x25\x39\x86\x0d\x90\xfc\x72\x31\x2f\xcb\xe0\x05\xbd\xc3\x53\x9e\xb1\x84\xcf\x20\x77\xc6\xe6\xc5\xc0\x71\xf0\xd0\x98\xa8\x9f\xab\x59\x9e\xa1\x8e\xaf\xfe\x94\x1c\x5d\x1f\xe8\x8a\x82\xb0\x99\x54\x28\x78\x9a\x2e\x97\x34\xc9\xa5\x26\x8e\xf3\x3b\xcd\x22\xce\x56\x39\x4b\x93\x71\xa5\x94\xa2\x29\x4b\x75\x98\x26\x53\x45\xff\x2e\x8c\xd7\x34\x1b\x93\x86\x4a\x62\x59\xea\xf5\x3c\x5c\x15\x03\x62\xfc\x2a\x7b\x6c\x90\x08\x0d\xa2\x6f\x8e\xac\xb4\x1e\x0e\xaa\x2f\x28\x02\xa5\xf8\x98\x1c\xf6\x4b\x29\xc4\x19\xa9\x8e\xd0\x5b\x1e\x15\xcf\x52\x6d\x47\x5f\x3b\xd1\xa2\xab\xab\x5f\xbb\xee\x75\x25\xb7\x6d\x02\x8b\xd8\x64\x56\x9b\xc1\x71\xe4\x72\x51\x18\x6b\xa6\x95\xdf\x26\x8b\x24\xbd\x4b\x84\x3e\xd6\x15\x5b\xbb\x8f\xbd\x29\x32\xc8\x24\xa5\x62\xa3\x14\xb4\xae\xe1\x0a\x7a\x9f\xdc\xdd\xb2\xe8\x96\xb0\x8c\xac\x25\x2d\xc9\x53\x92\xdf\xc2\xf3\xc9\xcb\x73\xb2\xa1\x3c\x03\x6a\xd7\xb7\x6e\x64\x5c\x13\x97\xca\xe1\xa0\xbe\xe5\x00\x61\x7e\x9e\xac\xd6\x39\x49\x6f\xfe\xa6\x51\x0e\x7b\x44\xb9\x57\x2e\xd4\x80\x17\x64\x17\x62\xd1\xc6\xbd\x24\xdc\x85\x7c\xe3\xb8\x19\x00\xc6\xb2\xb6\x8f\x06\x9a\x8c\x06\xb7\x96\xd0\x0c\x04\x4d\x78\xca\x68\x3c\xb5\x41\xdc\x62\x2b\x68\x10\xcb\xa5\x3c\x07\x66\x70\x66\xeb\x24\x22\x2c\x61\xb9\x37\x2c\x44\x60\xa8\x9b\xcf\x90\x56\x00\x5b\x09\x96\x02\x6a\x5c\x74\x5f\x39\x38\x99\x4e\x05\xa5\x94\xe8\xa1\xe6\x82\x95\x8e\x00\xdf\xc2\x85\xa1\xa2\x71\xaa\x78\x23\xf2\x58\x51\x44\x61\x1c\x2b\x55\x02\x5c\x23\x07\x00\x5d\xd2\xc4\xb6\xdc\x7f\xb5\x4e\x73\x3a\xad\x10\x8e\x77\x33\xa1\x5c\x0e\x0c\x14\x34\x3d\x9e\x11\x4e\xb3\x34\x46\xec\x18\xce\xd7\x6b\x9d\xee\x7f\xad\x0f\x08\x86\xc2\x8a\xa0\x8a\x3e\x12\x9c\xc2\x02\x2a\x28\x6f\xed\x45\xf9\x3d\x5e\x27\xbf\x97\xd7\x56\x59\xd6\x27\x0c\x4c\x9f\x53\x3e\x0b\x23\x5a\x94\x43\xe2\x5d\xa1\xff\x52\x79\xd5\x8a\xd7\xc5\x3a\x07\xb1\x67\x62\x19\xef\xcf\x29\xe7\x04\xfe\xa5\x1c\x5d\x06\xe1\xc9\xc8\xf1\x31\x49\x58\x4c\xd0\x83\x9c\xe6\x6b\x9e\xe0\xa3\x8f\xff\xe1\xcd\x9d\x90\xcf\x33\x9f\x5c\xe1\x45\x58\xe0\x2d\xc3\xd5\x65\x96\x73\x96\xcc\x27\xa6\xf4\x81\x73\x05\x80\x45\x52\x89\x6a\x90\xbf\x8e\x73\x58\x4a\xe8\x9d\xa7\xd5\x3a\x4d\x39\xe0\xb8\x57\x39\x60\x52\xdb\xaf\x86\x50\x6d\x16\x13\x44\x2a\xb7\x48\xf7\x9d\x67\xa7\x3c\x5d\x9e\x80\x74\x0d\x31\xd8\x43\x65\x2e\x6b\x08\x29\xec\x0b\x2f\x08\x4e\x0a\x53\x13\xf2\x43\x7d\x7f\x3b\xa7\x49\x19\x2c\x3b\xe1\x3c\x7c\xd0\xb2\xa4\xb6\x55\x0a\x63\x09\x2a\xb9\xaf\xac\xc0\xbb\x6c\x98\xcd\x41\xa3\xa3\xad\x82\xea\xb4\xb4\x8a\x71\x0e\xcc\xb8\x0c\x17\xd4\xab\xdd\x6b\xaa\x82\x5e\x8d\x69\xe2\xb1\x64\x28\xf9\xcd\x52\x4e\x98\x4f\x20\x6b\xa3\x6a\xd2\x9e\xa0\x66\x61\xbe\x03\x14\x07\x0d\xc4\xf7\xf0\xd6\x7f\x86\xa0\x20\xd5\x4b\x40\x91\x3b\xce\xc6\x47\xbc\x20\x2b\x3b\xa6\x44\x42\xd5\x0c\x88\x0b\xe2\x5c\x0c\x31\x7d\x0c\x84\xe0\x31\xc3\xb8\xf2\x67\x42\x0c\x42\x0c\x85\xaa\xec\xfd\x1e\xac\xe6\xc1\x82\x4f\xdc\x59\x08\x11\x33\xc5\xdc\xac\xbc\x4f\xae\xfb\x03\xe4\x1a\x6e\x57\x87\x23\x11\xda\xb9\x43\x2d\xb0\x52\x68\xa7\x99\x2f\xd9\x04\x2c\xbd\x19\x58\xe7\x10\x11\x71\x46\x8d\xb7\xe3\x9e\x7c\x1e\x61\xac\xe6\x9b\x74\xd0\x21\x77\x87\xdb\x2a\xf5\xb4\xa1\xf7\xf3\x9a\x17\xa5\x49\x14\xe6\xc4\x15\x00\xfe\xe0\xba\x64\x1b\x82\x89\xfb\xc1\x9d\xb8\x43\xc3\xcb\x3d\x4e\xfe\xc6\x3e\xde\xdb\x33\xb5\x7b\x2b\xa3\xda\xce\xdd\x87\xc3\xd7\xb3\x67\x2b\xfb\xd4\x4f\x3b\xd2\x5d\xd9\xc8\x5d\xf6\xdf\xba\x1e\x49\x68\x3a\xb3\x53\xea\x45\x42\x2f\x66\x8d\xbc\xaa\xa8\x59\x32\xa5\xf7\xbe\xf5\x2e\xc7\xf3\xad\x57\x39\x42\xe0\xa3\x22\x27\x3f\x1b\xf9\x77\x57\x36\xbc\xf2\x49\xba\x78\x4c\xf2\xfc\x15\xe9\x0f\x0f\x77\x33\xae\xf1\x48\x1a\xbf\x3d\xa2\x88\x74\xfc\x1e\x17\x4c\xdb\x5d\x7e\xa5\x1c\xde\x25\xa7\x19\x4e\xa4\xe7\xf7\x89\xf1\x95\xa2\xb7\xad\x10\xda\x69\x4b\x88\xb1\x2e\x05\xca\x2e\xcb\x56\xa1\xd4\x69\xc2\xaf\x6b\xbd\xba\x58\xdb\xea\xf2\x93\x2c\x63\xf3\x04\x8a\x17\xb4\xd3\x8a\xf6\x7b\xbc\x4e\x04\x12\xf5\xbb\x13\x41\x8b\xb5\xbb\x71\x7b\x54\xdd\x6e\xa9\x7d\x44\x6f\x3a\xb9\xd6\xb9\xa3\x04\x7b\x28\x19\x52\xd8\xf7\x78\xfc\x1e\x8f\xdf\xe3\xf1\x5f\x8d\x47\xdd\x3e\xaa\xce\x52\xbe\x9a\xe5\x1f\x03\x5d\x31\x49\x59\xb2\xf7\xb2\x9a\x4e\xe8\x39\xa5\x5c\xdd\x74\x76\xce\x19\x0e\x64\xa7\x29\x29\x3f\x7d\xd2\xd0\x1e\x32\xd8\xf3\x05\x3d\x67\xdb\x32\x59\x70\x9c\x8e\x99\x82\x5c\x2a\x4a\x63\x02\xa7\x8e\xbf\x48\xa7\xba\x0a\x3b\xd7\xed\x11\x9c\xbd\x9c\x1c\x59\xfd\xbe\x6e\x9c\x80\xe9\x13\xb0\x55\x1c\x3e\x28\x9d\xe1\x78\xb5\x2b\xd8\x9f\x67\xd8\x1a\x5d\xcc\xc6\x04\xbb\x6b\x6f\x65\x8d\x36\xd4\xde\xcb\x90\x87\xcb\x6c\x48\x6e\xd2\x54\xd5\xae\xd9\x1d\xcb\xa3\x5b\xb2\x92\xa1\x10\x78\x38\x12\x1d\xca\xad\x28\x04\x88\x1c\xd5\x1d\x98\xbe\x77\xd5\x82\xf5\x6e\x8d\x07\x46\x49\x9c\xf3\x35\xd5\x05\x9b\x5e\x9b\x85\x00\x3f\x35\x5c\xb2\x2a\xb7\xce\x11\x4b\xe5\xf5\x1a\x86\xaf\xe1\x04\xe5\x72\xd6\x70\x60\xe2\x53\x6f\x58\x93\x97\xf6\x76\x20\xff\x50\x95\x9d\xc6\xd3\x41\xd9\xac\x2a\xfb\x9d\x06\x1b\x07\x0b\xfa\x70\xaa\x6b\xc5\x04\xb6\xfe\xd0\xcf\x9a\xa1\x26\xdd\x6f\xf8\x03\x85\x68\xed\xe0\xf3\xdf\xab\x78\x43\x4b\x1b\x3b\x67\x71\x7a\x13\xc6\x6a\xdf\xeb\x47\x64\x07\x0e\x54\xaa\xd3\x30\xf0\x8c\xc6\xdc\x57\x23\x12\x0b\x16\x19\x8f\xf0\x6e\xab\xe0\xaf\x74\xcd\x23\x0d\x0f\xd9\xf4\xec\x80\x87\xc4\x00\x56\xc5\xc8\xe4\xd8\x6e\x97\x5a\x43\x17\xa3\xa7\xc9\x50\xe2\x11\x1c\x1a\x98\xa4\x3a\xd3\x69\x93\x4b\xbc\x12\x37\x73\xe5\x90\x4b\x73\x11\x5a\xed\x52\x6a\x17\x4f\x1e\xd9\x5c\x4d\xe8\x76\xbc\xf1\x20\x7f\x78\x6e\x26\x2c\x44\xa0\xdd\xa8\xc6\xac\x20\x52\x34\x6c\xe5\x70\xd8\x84\x79\x23\xd1\x3d\x0f\x57\x99\xd5\xee\x65\x5b\x86\xaa\x40\xfc\xff\x98\xab\xbe\xb9\x05\x53\x79\x02\xa5\xc3\xbd\x0e\x34\xea\x91\xca\x8d\x7b\x8c\x70\xe5\xf0\x1d\x3c\xdd\x98\xb4\xef\x35\x51\x55\x77\x86\xf0\x96\x94\x7a\xb6\xaa\xe6\xc2\x4e\xf5\xf9\x45\x7c\x36\xf8\x0c\x09\x02\x80\x9d\x32\xda\x65\x4f\x39\xac\xa6\xd2\xbb\xc0\xb4\xe7\xb8\xd6\x06\x96\x3d\xb1\x75\xbe\xdc\xc8\x16\xe7\xa9\x1d\x63\x5b\xb0\xae\x0e\xd3\x49\xc7\xb6\x30\x4d\xdf\x50\xb7\x35\xd5\xed\xc8\x30\x18\xc4\x72\x74\xc9\x3a\x66\x92\x6a\x7a\x3b\x56\x73\xc7\x2f\xa0\x23\x72\x55\x73\x49\xd6\x39\x98\xc4\xf1\x31\xc3\x3f\xd4\x2e\x52\x6d\x99\x36\x3b\xce\xc2\x97\x35\x2c\xd0\x5d\x0a\x28\x4f\x7c\xf9\xb7\x84\xdd\x44\x30\xc5\xb9\xf5\x31\x59\xf8\x9b\x6a\x56\x61\xa9\xdf\x3f\x47\x5b\x2c\x5a\x2d\x46\xd7\x41\xe2\x2e\xea\x39\x5e\xe7\x30\x6c\x7b\x6b\x30\xeb\xed\x0d\x7e\xfc\x69\x8a\xd8\x80\xd2\x94\x8a\x4f\x74\x78\x43\xb0\xdd\xd0\x9c\x10\x5a\xb3\xab\xc5\xe2\x31\xca\x36\xbe\xd3\x59\x86\xb1\xfa\x80\xb6\x69\x36\x9b\x7e\xd3\x34\xc7\x9c\xdf\xc8\x38\xd2\xe5\x5b\xcd\xb3\xd9\x3c\x4e\x61\xcb\x40\x32\x1e\x2e\x17\x8b\xc9\xf1\x66\xa3\xa2\xa7\xa3\x62\x6f\x7f\x27\x12\xd9\x27\xdd\x5d\xb8\x57\x29\xe7\x3f\x50\xba\xab\x8c\xba\xa5\x80\xaf\x3f\x84\xb6\xcb\xd3\x3d\xbf\xec\x49\x38\x1f\x36\x79\x0b\x5c\x28\x0d\x05\x09\x3e\xef\xf7\xfa\x11\xa4\xca\x93\xe3\xcf\xa9\xf6\x9c\x56\x9d\xb7\x25\x0b\x75\x97\x74\x5d\x15\x5d\x69\xb6\x01\x70\xa4\x4a\x5a\xba\xac\xf2\x45\x71\xf4\x18\x23\x6a\xd8\x6f\x35\xa3\x22\xea\x35\x64\xc7\x5b\xf6\xcb\x99\xb2\x4b\x56\x1d\x6d\xab\x2a\xda\xaa\xcb\x97\xc6\xb7\xf5\x7f\x00\x00\x00\xff\xff\x03\x00\x29\x6a\x87\xe2\x99\x22\x00\x00")
 
 func templatesTypes_bodyGohtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -169,7 +169,7 @@ func templatesTypes_bodyGohtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/types_body.gohtml", size: 7754, mode: os.FileMode(420), modTime: time.Unix(1550757657, 0)}
+	info := bindataFileInfo{name: "templates/types_body.gohtml", size: 8857, mode: os.FileMode(420), modTime: time.Unix(1792408113, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -194,7 +194,7 @@ func templatesTypes_headGohtml() (*asset, error) {
 	return a, nil
 }
 
-var _templatesTypes_serviceGohtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x58\x4d\x8f\xdb\x36\x10\xbd\xef\xaf\x98\x08\x69\x20\x07\x8a\x0c\xf4\xe8\x62\x0f\xa9\xbb\x9b\x06\x48\xf3\xb5\x2e\x72\x0c\xb8\xd2\x48\x26\x2c\x93\xf2\x88\xda\xec\x82\xd0\x7f\x2f\x48\x51\xb6\x6c\xeb\xcb\x4d\x5a\x84\xb7\x35\xc9\xe1\xcc\xbc\x99\x37\x4f\xab\xf5\x2b\x98\xbf\x4c\xa5\x7a\xca\x71\x01\x29\x57\xeb\xf2\x3e\x8c\xe4\x76\x7e\xf3\x66\xf5\xea\xef\x0d\x31\x2e\x70\x9e\xca\x5f\xd3\x5d\x36\x4f\x51\x20\x31\x25\x69\x9e\x67\x65\xca\x45\x31\x4f\x89\xe5\xeb\x5d\x16\xde\x21\x3d\xf0\x08\x97\x52\x28\x7c\x54\x2f\xe7\xf0\xaa\xaa\xae\x92\x52\x44\xf0\x06\x95\xd6\xcd\x7e\xf8\x9e\x6d\xb1\xaa\xdc\x5f\x5a\x87\xb7\x1c\xb3\x78\xf5\x94\x63\x55\xfd\x85\x6a\x2d\xe3\xc2\x8f\x40\xeb\x54\x9a\xdf\x60\x7f\x6d\xc9\xb2\xec\xad\x50\x48\x09\x8b\xb0\xaa\x02\xe0\x6b\x78\xa9\x35\x37\x3f\x45\x98\x2b\x49\xc5\xc7\x4d\x5a\x55\xe1\xdb\xc3\x2f\x7f\x32\x11\x67\x48\xa0\x35\xf0\x04\x9e\x87\x2b\x62\x11\xd2\x8d\x60\xf7\x19\xc6\x50\x55\x10\x28\xb3\x29\x73\x14\x8a\x58\xc4\x45\x5a\x9b\xa8\xcf\x81\xd6\x28\xe2\xaa\x9a\x19\x6f\x76\x59\xbd\x65\xbd\x2d\x40\x5f\x01\x80\xb3\xdb\xb8\xe8\xbc\xb7\x71\x83\x5b\x84\xaa\x24\x71\x6e\x40\xef\x4f\xd4\x76\x88\x89\x14\xe1\xf9\xd6\x9a\x80\xc5\xf5\xa0\xd1\x66\x79\x5a\xbb\x1b\x2e\xa9\xde\x02\x5e\x9c\x3e\xa5\xcf\xae\x99\x65\xce\x2f\x3a\x0c\x04\x9d\xa7\xff\xc0\x22\x22\x9e\x2b\x2e\xc5\x02\x0e\x77\x3e\x95\x52\x61\xbc\x94\xdb\x2d\x0a\x55\x55\xdd\x77\x57\xb6\xa8\xb4\x8e\x58\x96\x35\x01\x86\x6f\x4c\xcd\x7c\x7a\xf7\xa1\x54\x79\xa9\x2c\xce\xcf\xc3\xdf\x65\xfc\xe4\x8a\xa7\xcf\x96\xc3\xd1\x19\x79\x4d\x69\x69\x5e\xee\x4e\x4e\xb3\x5e\x53\x5a\x2c\xce\x00\x58\x4a\x91\xf0\xb4\xb1\xd0\x9d\xa3\xd6\xab\x0e\x1e\x46\xa9\xc1\xe6\x32\x07\x9a\x65\x92\xcd\x28\xed\x86\xaa\x31\x55\xfb\xa5\x8f\x93\x66\x6e\x75\x26\xe9\x0c\x18\x73\xf2\x04\x95\x9e\x54\xb6\x82\x43\x11\x0f\x06\xd0\x0f\xc6\xd0\xcd\xcf\x58\xc8\xec\x01\x17\x60\x28\xc0\xcf\xdb\x08\xb8\xad\x8f\x8c\xd8\xb6\x98\x81\xff\x15\x78\xd3\xd8\xba\x0a\x80\x90\x08\x90\x48\xd2\x0c\xfa\x91\x89\xd4\xa3\xc1\x22\x0f\x5d\x3a\x7a\x0f\x7e\x85\x6b\x73\xb8\x77\xbf\x9b\x1c\xc6\x10\x7d\x60\x04\x39\x23\x14\xea\x2e\x67\x62\xa9\x1e\xbb\x78\xc4\x6e\x8d\xb8\x67\x16\x4f\x9c\x2d\x13\x51\x8f\x9d\x5b\x92\x5b\x67\xcb\xdf\x07\x3d\xfb\xad\xb9\xf8\xec\x1a\x04\xcf\x06\xf2\xe5\xd6\xb1\xcf\xd7\xee\xef\xc6\x9e\x3f\x1b\xbc\x3f\x9c\x92\x22\x67\xc2\x04\xa0\x28\xbc\x53\x8c\xec\x23\xbe\xa9\xfa\x13\xea\x0f\x4f\x59\xa7\xa9\x15\xf2\x82\xae\xe8\x97\x6b\x9e\xc5\x1f\x12\xff\xc8\xf5\xd9\xb0\xa7\xc5\x21\xf3\x3d\x39\x75\xbb\x5f\xb8\x5a\x5b\x47\xf7\x39\x0d\xec\xe5\x61\xf3\x31\x26\x48\xf6\x5c\x78\xcb\x05\x2f\xd6\x23\x89\xdb\x1b\x87\xeb\xb6\x6b\x83\x77\x22\x8b\xcf\xd4\xd3\xb5\x47\xb6\xd7\x66\xa3\x45\x60\x0a\xce\x76\xd9\xd4\xaa\xb1\xcb\x86\x7b\x87\x6a\xc5\x52\xdf\xb3\xfd\xe9\x05\xa0\xa8\xc4\x59\xf8\x4e\xa6\xf5\x5c\xf3\xb5\xce\xa4\xcb\xf0\x8d\x39\xe2\x9b\x77\x46\xc0\x82\xd1\xd2\xaa\x06\xf2\x6b\xa7\xf4\x60\xbf\xf2\xc4\x88\x85\xeb\x29\xb1\x1e\x4f\x98\xcf\xb8\x2b\xb1\x50\x4d\x79\x4e\xa2\x79\xc2\x5d\x60\xd8\xab\x2e\xbb\xa3\xa9\x77\x6a\xce\xcb\x0d\xef\x17\xde\x29\xaf\x1b\x3d\xc6\x13\x10\x52\xf5\x5d\x35\x55\x7b\x43\xd6\xa1\xa0\xa6\x4a\x5f\xf0\x6c\xe6\x04\xcb\x14\xf8\x2f\x46\xdf\x29\x19\xc1\x33\xfb\xe2\x77\x02\x0a\x6d\x6d\x74\x94\xa4\x65\xc6\x51\xa8\x5a\xf6\x18\xcd\x67\xf2\x14\x79\xe0\x11\xee\xce\x12\x35\x3a\xd9\xb2\x02\xa7\x82\x66\xf0\x12\xf8\xcd\x6f\x94\xe7\xad\xa4\xf7\xf8\xed\x14\x80\x5a\xa7\x8e\x97\xf3\xff\x10\xdb\xd8\xd4\xee\x6f\x07\x37\x3a\x5f\x74\xa9\x67\xf7\xfe\x70\x4d\x38\x3e\xaf\x05\xe4\x09\xbb\xf7\x48\xc8\x66\xd5\xc1\x4f\x97\x9e\xcd\xaa\xc5\xc2\x02\xf2\xfe\x63\xfd\x11\xb7\x7b\x92\xaf\x1b\xfd\x61\x7a\xcf\x37\xc9\x08\x6a\xde\xb4\x79\xe9\xfc\xa4\xd8\x4f\x06\x61\x28\xbc\xeb\x44\xcb\xe4\x5b\xf1\x20\x37\x48\x33\xf0\x09\x8b\x32\x53\xc7\xe2\x66\x92\xb6\x81\x83\xbe\x31\x4e\x85\x75\xf0\xa3\x4a\x07\x26\xa8\x1d\xf8\x41\x24\xd7\x55\xde\x3f\x05\xbf\x5d\xd6\xf6\x35\xa7\x4d\x6d\xfb\xc0\xd0\xdf\x77\x37\x66\x3f\x7b\x5c\xc2\xcb\x6d\x3e\xd6\xda\xe6\xc8\xd5\xe2\x17\x62\xb9\x8f\x44\x01\x78\x09\xe3\x46\xcc\x2a\x09\x54\x67\x16\x98\x01\xa4\xdf\x83\xa1\x1e\xb2\xef\xf1\xb5\xfd\x12\x77\x7d\x63\x1b\x6b\x6a\xf3\x18\x92\x3d\xea\x85\xde\x6e\x32\x2f\xd4\x44\xf1\x83\x9a\x89\x02\x90\x1b\xd3\x4e\x84\xbb\x70\x8f\xf5\xbf\x21\x77\x9e\xc0\x33\xb9\x99\x30\x33\xfb\xf1\x79\x6f\xcb\x2d\xd9\xaa\x46\xdc\x13\x17\x2a\xf1\xbd\xcf\x2d\x88\xa0\x95\x14\x67\xaa\x80\x7b\x16\x9b\x00\x8c\xab\xa0\x9e\x72\xf4\x7f\x59\xcd\x42\xb8\x5b\xcb\x32\x8b\xe1\xde\x7e\x30\x0e\x05\xe6\x59\x08\x46\xb4\xd8\x70\xd3\xd4\x9a\xf4\x52\x4e\xd2\xba\x45\x37\x1f\xd9\x53\x26\x59\x6c\xc5\xe1\x72\x8d\xd1\x66\x32\xe5\x14\x7d\xba\xaa\x77\xac\x5e\x36\x54\xe1\xa7\x51\x46\x3c\x39\x0d\xb1\x2b\x6b\x1e\x61\xe1\x55\xd5\x44\x2f\x8f\x49\xbf\x6d\xef\x75\x14\x61\x51\xc8\x69\x30\xec\x5d\xac\x6b\xe0\x60\x05\xce\x60\xe9\x7c\xc3\x39\x3d\xd5\xe5\xc9\x54\x3e\xe4\x17\x61\x31\xf9\xbd\x11\xf2\x6e\xd6\xe4\xd9\xd2\x94\xed\x48\x83\x5c\x3a\xb2\xfe\x9b\x0e\x18\xff\x94\xea\x61\xc7\x8e\x7f\x11\x9d\xfc\xd4\x91\xdb\xea\xaa\x27\xf6\x43\x43\x5d\x1d\x7b\x55\x5d\xfd\x13\x00\x00\xff\xff\x07\x64\xd6\xa4\xab\x16\x00\x00")
+var _templatesTypes_serviceGohtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x58\x5b\x6f\xdb\x36\x14\x7e\xf7\xaf\x60\x85\xac\x90\x02\x45\x06\xf6\xe8\xc2\x0f\x9d\x97\x64\x01\xba\x36\x4d\x3c\xf4\xb1\x60\x24\x5a\x26\x22\x8b\x0a\x49\x65\x4d\x09\xfd\xf7\x1d\x52\x94\x23\xc9\xba\x39\xe9\x80\x10\xc8\x43\x44\xf2\xdc\xcf\x77\x3e\x5a\xa9\x33\x34\x3f\x8d\x99\x7c\xca\xc8\x02\xc5\x54\x6e\xf3\xbb\x20\x64\xbb\xf9\xf9\xe5\xfa\xec\x9f\x7b\x8e\x69\x4a\xe6\x31\xfb\x3d\x7e\x48\xe6\x31\x49\x09\xc7\x92\xf1\x79\x96\xe4\x31\x4d\xc5\x3c\xe6\x38\xdb\x3e\x24\xc1\x2d\xe1\x8f\x34\x24\x2b\x96\x4a\xf2\x43\x9e\xce\xd1\x59\x51\xcc\x36\x79\x1a\xa2\x4b\x22\x95\xaa\xf6\x83\xcf\x78\x47\x8a\xc2\xfe\x07\xdf\x2f\x28\x49\xa2\x35\xa8\x2e\x8a\xbf\x89\xdc\xb2\x48\xb8\x21\x52\x2a\x66\xfa\x1b\xda\x5f\x5b\xe1\x24\xb9\x02\xd1\x7c\x83\x43\x38\xea\x23\xba\x45\xa7\x4a\x51\xfd\x29\x24\x19\x58\x24\xae\xef\xe3\xa2\x08\xae\x9e\xbf\xfc\x85\xd3\x28\x21\x1c\xa4\x21\xba\x41\x27\xc1\x9a\xc3\x5d\x7e\x9e\xe2\xbb\x84\x44\xa8\x28\x90\x2f\xf5\x26\xcb\x48\x2a\x61\x8b\xa6\x71\x29\xa2\x3c\x07\x3b\x24\x8d\x8a\xc2\xd3\xd6\x3c\x24\xe5\x96\xb1\x56\x20\x35\x43\xb0\x4a\xb9\x95\x89\xd6\x7a\xe3\x37\xb2\x8b\x13\x99\xf3\xf4\x50\x80\xda\x9f\x28\xe5\x70\x9c\xc6\x04\x9d\xec\x8c\x08\xb4\x58\x0e\x0a\xad\x96\xa3\x94\xbd\x61\x83\xea\x2c\xd0\xfb\xb6\x2a\x75\x70\x4d\x2f\x7d\x7e\xd1\x21\xc0\xef\x3c\xfd\x27\x11\x21\xa7\x99\xa4\x2c\x5d\xa0\xe7\x3b\x5f\x73\x26\x49\xb4\x62\xbb\x1d\xc4\x0f\x52\xd2\x79\x77\x6d\x8a\x4a\xa9\x10\xf2\x57\x39\x18\x5c\xea\x9a\xf9\xfa\xe9\x4b\x2e\xb3\x5c\x9a\x3c\x9f\x04\x7f\xb0\xe8\xc9\x16\x4f\x9f\x2c\x9b\x47\x2b\xe4\x23\x8f\x73\xad\xb9\x3b\x38\xd5\x82\x53\x62\x71\x90\x00\x50\xb4\xa1\x71\x25\x41\xf5\xde\xb6\x5a\x6d\x7a\x30\x8f\x75\x6e\x8e\x33\xa0\x9e\x2d\x10\xd0\x9d\xaa\x4a\x54\x69\x97\x6a\x06\x4d\xdf\xea\x0c\xd2\x41\x62\xf4\xc9\x56\x56\x7a\x42\x59\x73\x0e\x8a\x7c\xd0\x81\xfe\x64\x0c\xdd\xbc\x21\x82\x25\x8f\xe0\x85\x86\x00\x37\xab\x67\xc0\x6e\x5d\x63\x8e\x77\xc2\x43\xee\x77\x44\xab\xc6\x56\xe0\x14\x27\x9c\x23\xf8\x63\xdc\x43\xfd\x99\x09\xe5\x0f\x9d\x8b\x2c\xb0\xe1\xe8\x3d\xf8\x1d\x2d\xf5\xe1\xd9\x40\x04\x3a\xc0\x61\x2c\xa3\x8f\x98\xa3\x0c\x73\x08\xf1\x6d\x86\xd3\x15\x18\xd3\x81\x23\x66\x6b\xc4\x3c\xbd\x40\x7f\x29\x4b\x7b\xd4\x23\xe7\x82\xb3\x9d\x95\xe5\xee\x9d\xf6\x3e\x54\x17\xdf\x2d\x51\x4a\x13\xa4\xc6\xca\xb0\x69\xf3\xd2\xfe\x5f\xc9\x73\xbd\xc1\xfb\xc3\x21\x11\x20\x54\x3b\x20\x79\x70\x2b\x31\x37\x4a\x5c\x5d\xf5\x2d\xe8\x0f\xda\xa8\x53\xd5\x0a\x77\xfc\x2e\xef\x57\x5b\x9a\x44\x5f\x36\x6e\xc3\x74\xcf\x1b\xb5\xc5\xfa\xd4\x13\x53\xbb\xfb\x0d\xa6\x9d\x31\x74\x1f\x53\xdf\x5c\x1e\x16\x1f\x91\x0d\xcc\x07\x7d\x0e\xe0\x24\xa5\x62\x3b\x12\xb8\xbd\x70\x88\xb8\x98\x58\x14\xa1\xc9\xcf\xd4\xd3\xa5\x45\xa6\xd7\xbc\xd1\x22\xd0\x05\x67\xba\x6c\x6a\xd5\xec\x63\x0a\xa9\x94\x6b\x1c\xbb\x8e\xe9\x4f\xc8\x97\xe4\x39\xf1\x82\x4f\x2c\x2e\xe7\x9a\xab\x54\xc2\x6c\x84\xcf\xf5\x11\x57\xeb\x19\x49\xd6\x78\x69\x15\x03\xf1\x35\x53\x7a\xb0\x5f\xc1\x5b\x20\x0b\xcb\x29\xbe\xb6\x26\x4c\x2e\xb7\x93\xb0\xdd\x8e\x79\x90\xaf\x2b\xd8\xc4\xc6\xb2\x91\xcf\xe4\xdf\x69\x3d\xc0\xc9\x43\x4e\x39\x11\x08\x83\x52\xc6\xe9\x4f\xac\x51\xdd\x47\x77\xb9\x44\x75\x92\x83\xb6\x96\xd4\x50\x81\x52\x26\x91\x20\xd2\xf1\x46\x21\x3e\x11\xa4\xee\xd8\x0d\x28\x23\x42\x56\x7d\x37\xd1\xc7\x07\x5f\xc3\x72\xd9\x4f\x8d\x71\xde\x16\xe7\x64\x7a\xa0\x09\xa7\x3d\xb0\x14\x10\x4d\xb0\x42\x9b\xdd\x73\x55\xb7\x23\xd4\x8d\x36\xc8\x2f\x67\x80\x0b\x41\xf5\x2c\x13\x9b\x52\xd7\x47\x97\x75\x3d\x77\x70\xf9\x95\x95\xda\x20\x7d\x8d\x20\xad\x12\x0a\x10\x54\xf2\x39\x4d\x66\x75\x9c\x42\x07\x39\x10\xd7\x83\x40\x4d\xca\xe7\xc4\xa4\xe9\x7c\xa5\x50\x85\x15\xa5\xbe\x60\x1c\x8a\xb2\x9d\x80\x92\x80\x7b\x6f\xc1\xb7\x31\x3a\xd2\xdf\xe7\x96\x13\xbc\xef\x7a\x16\x58\xfd\xc3\x35\x61\x9b\xb4\x64\xc6\xad\x96\x75\x86\x89\x54\xe9\xfc\x74\x4e\x5d\xad\x92\x05\x2d\x50\xe6\xbf\xc0\xe3\x17\xc0\x95\x6d\x11\x08\x13\xdd\x9a\x1b\x1a\x6b\x88\xab\x63\xa7\xb1\x4b\xa3\xcf\x35\x4b\x68\xf8\xd4\x10\x0b\xa5\xf1\xe1\xb8\xd6\x3a\xa6\xad\x06\x1d\x1c\xab\x87\x3a\x2e\x81\x4b\x16\x49\x34\xfe\x58\xa7\xcc\x50\x34\xb5\x71\x3a\x50\x18\x3e\x34\x09\xcc\xe7\xae\x13\x35\x91\x57\xe9\x23\xbb\x27\x40\x4c\x61\xae\x89\x3c\x91\x4d\xe6\x3a\x89\xb8\xd6\xc8\xab\x36\x2a\x28\x0b\x20\x98\x32\xe4\xc7\xa8\xec\x61\x49\xbc\x0c\xe8\xbb\x5a\xfc\x4d\x60\xfc\x71\xd0\x57\x16\xe0\x54\xe8\xf3\x75\xad\xbe\x1a\x9c\xbc\xd9\xaf\x98\x4d\xfd\x7c\xe2\x1b\xbc\x9c\x5d\xf8\xe0\x23\x67\x83\xa9\x7e\xa9\x48\x06\xc7\x4d\x64\x11\xd6\x09\xf1\x5e\x80\x23\x56\x1f\xb4\x8f\x46\x6f\xdb\x37\xa6\xb1\xa6\x36\x8f\x1e\x34\x8d\x5e\xe8\xed\x26\xad\xa1\x04\xcb\x5f\xd4\x4c\x10\x0b\x76\xaf\xdb\x09\x6c\x08\xf6\xb9\x7e\xc9\x80\x83\x14\xbd\x03\x51\xea\x95\x7c\x4f\xa9\xcd\x4e\x56\x2f\x37\x0e\x4e\x6d\x5c\xe7\xa6\x96\xa2\x3a\x9f\xb3\xa2\x04\xba\xc3\x91\xe1\x80\x60\x2a\xd2\x3f\xc4\xb9\xbf\xad\xbd\x00\xdd\x6e\x59\x9e\x44\xe8\xce\xfc\x1a\x30\xe4\x98\x63\x52\xe0\xbd\xe6\xfd\x56\x3e\x38\x8e\xc5\x24\x48\xf1\x33\xdc\x5c\xe3\xa7\x84\xe1\xc8\x30\xff\xd5\x96\x84\xf7\x93\x21\x47\xf4\x71\xcb\x5e\x6a\x71\x1c\xb1\x78\x3b\xec\x10\xac\x68\xb9\xd8\x15\x35\xa0\x4e\xc2\x81\x67\xc1\x34\x2b\x9b\xa0\x5f\x97\xf7\x31\x0c\x89\x10\x6c\x5a\x1a\xea\x44\xaa\x21\x05\x2d\x87\x6c\xde\xeb\xb0\x46\x4f\x35\x79\x32\x94\x0f\xd9\x05\x2a\x27\xeb\x4b\xa3\x49\xea\x26\xcf\x96\xaa\x6c\x47\x1a\xe4\xd8\x91\xf5\xff\x74\xc0\xf8\x3b\xb9\x07\x1d\x3b\x7e\xff\x6b\x7d\xea\x88\x6d\x31\xeb\xf1\xfd\xb9\xa1\x66\x4d\xab\x8a\xd9\x7f\x00\x00\x00\xff\xff\x03\x00\x3b\xa6\x9b\x5c\x88\x18\x00\x00")
 
 func templatesTypes_serviceGohtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -209,7 +209,7 @@ func templatesTypes_serviceGohtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/types_service.gohtml", size: 5803, mode: os.FileMode(420), modTime: time.Unix(1556037180, 0)}
+	info := bindataFileInfo{name: "templates/types_service.gohtml", size: 6280, mode: os.FileMode(420), modTime: time.Unix(1792405708, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -1,5 +1,22 @@
 package graphql
 
+import "github.com/pkg/errors"
+
+const (
+	Int64FormatNumber = "number" // 64-bit integers are serialized as JSON numbers
+	Int64FormatString = "string" // 64-bit integers are serialized as JSON strings, like in proto3 JSON mapping
+)
+
+// ValidateInt64Format returns error, if 64-bit integers format is unknown.
+func ValidateInt64Format(format string) error {
+	switch format {
+	case "", Int64FormatNumber, Int64FormatString:
+		return nil
+	}
+
+	return errors.Errorf("unknown int64_format '%s'. Allowed values: %s, %s", format, Int64FormatNumber, Int64FormatString)
+}
+
 func GqlStringTypeResolver(ctx BodyContext) string {
 	return ctx.Importer.New(GraphqlPkgPath) + ".String"
 }
@@ -49,6 +66,14 @@ func GqlUInt64TypeResolver(ctx BodyContext) string {
 	return ctx.Importer.New(ScalarsPkgPath) + ".GraphQLUInt64Scalar"
 }
 
+func GqlInt64StringTypeResolver(ctx BodyContext) string {
+	return ctx.Importer.New(ScalarsPkgPath) + ".GraphQLInt64StringScalar"
+}
+
+func GqlUInt64StringTypeResolver(ctx BodyContext) string {
+	return ctx.Importer.New(ScalarsPkgPath) + ".GraphQLUInt64StringScalar"
+}
+
 func GqlUInt32TypeResolver(ctx BodyContext) string {
 	return ctx.Importer.New(ScalarsPkgPath) + ".GraphQLUInt32Scalar"
 }
@@ -7,11 +7,11 @@ import (
 	"os"
 	"reflect"
 	"strings"
-	"text/template"
 
 	"github.com/pkg/errors"
 	"golang.org/x/tools/imports"
 
+	"github.com/EGT-Ukraine/go2gql/generator"
 	"github.com/EGT-Ukraine/go2gql/generator/plugins/graphql/lib/importer"
 )
 
@@ -19,6 +19,11 @@ const (
 	ScalarsPkgPath       = "github.com/EGT-Ukraine/go2gql/api/scalars"
 	MultipartFilePkgPath = "github.com/EGT-Ukraine/go2gql/api/multipartfile"
 	InterceptorsPkgPath  = "github.com/EGT-Ukraine/go2gql/api/interceptors"
+	FederationPkgPath    = "github.com/EGT-Ukraine/go2gql/api/federation"
+	RelayPkgPath         = "github.com/EGT-Ukraine/go2gql/api/relay"
+	ComplexityPkgPath    = "github.com/EGT-Ukraine/go2gql/api/complexity"
+	VisibilityPkgPath    = "github.com/EGT-Ukraine/go2gql/api/visibility"
+	EnumsPkgPath         = "github.com/EGT-Ukraine/go2gql/api/enums"
 	GraphqlPkgPath       = "github.com/graphql-go/graphql"
 	OpentracingPkgPath   = "github.com/opentracing/opentracing-go"
 	ErrorsPkgPath        = "github.com/pkg/errors"
@@ -30,6 +35,7 @@ type typesGenerator struct {
 	tracerEnabled              bool
 	imports                    *importer.Importer
 	outputObjectFieldRenderers []OutputObjectFieldRender
+	generateCfg                *generator.GenerateConfig
 }
 
 func (g typesGenerator) importFunc(importPath string) func() string {
@@ -71,6 +77,7 @@ func (g typesGenerator) bodyTemplateFuncs() map[string]interface{} {
 		"errorsPkg":       g.importFunc(ErrorsPkgPath),
 		"gqlPkg":          g.importFunc(GraphqlPkgPath),
 		"scalarsPkg":      g.importFunc(ScalarsPkgPath),
+		"enumsPkg":        g.importFunc(EnumsPkgPath),
 		"interceptorsPkg": g.importFunc(InterceptorsPkgPath),
 		"opentracingPkg":  g.importFunc(OpentracingPkgPath),
 		"concat": func(st ...string) string {
@@ -81,6 +88,13 @@ func (g typesGenerator) bodyTemplateFuncs() map[string]interface{} {
 		},
 		"goType":       g.goTypeStr,
 		"goTypeForNew": g.goTypeForNew,
+		"relayPkg":     g.importFunc(RelayPkgPath),
+		"nodeKeyField": func(object OutputObject) *ObjectField {
+			return object.FindFieldByName(object.Node.KeyField)
+		},
+		"authPolicy": func(cfg *AuthConfig) string {
+			return policyLiteral(g.imports.New(InterceptorsPkgPath), cfg)
+		},
 	}
 }
 
@@ -98,11 +112,7 @@ func (g typesGenerator) headTemplateFuncs() map[string]interface{} {
 
 func (g typesGenerator) generateBody() ([]byte, error) {
 	buf := new(bytes.Buffer)
-	tmpl, err := templatesTypes_bodyGohtmlBytes()
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to get body template")
-	}
-	bodyTpl, err := template.New("body").Funcs(g.bodyTemplateFuncs()).Parse(string(tmpl))
+	bodyTpl, err := g.generateCfg.ParseTemplate("types_body.gohtml", Asset, g.bodyTemplateFuncs())
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to parse template")
 	}
@@ -116,14 +126,13 @@ func (g typesGenerator) generateBody() ([]byte, error) {
 
 func (g typesGenerator) generateServicesBody() ([]byte, error) {
 	buf := new(bytes.Buffer)
-	tmpl, err := templatesTypes_serviceGohtmlBytes()
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to get services body template")
-	}
-	servicesTpl, err := template.New("service").Funcs(g.bodyTemplateFuncs()).Parse(string(tmpl))
+	servicesTpl, err := g.generateCfg.ParseTemplate("types_service.gohtml", Asset, g.bodyTemplateFuncs())
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to parse template")
 	}
+	if err := validateAuthTemplate(servicesTpl, servicesHavePolicies(g.File.Services)); err != nil {
+		return nil, err
+	}
 
 	for _, service := range g.File.Services {
 		queryServiceContext := ServiceContext{
@@ -158,11 +167,7 @@ func (g typesGenerator) generateServicesBody() ([]byte, error) {
 
 func (g typesGenerator) generateHead() ([]byte, error) {
 	buf := new(bytes.Buffer)
-	tmpl, err := templatesTypes_headGohtmlBytes()
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to get head template")
-	}
-	bodyTpl, err := template.New("head").Funcs(g.headTemplateFuncs()).Parse(string(tmpl))
+	bodyTpl, err := g.generateCfg.ParseTemplate("types_head.gohtml", Asset, g.headTemplateFuncs())
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to parse template")
 	}
@@ -177,10 +182,12 @@ func (g typesGenerator) generateHead() ([]byte, error) {
 func (g typesGenerator) generate(out io.Writer) error {
 	fieldsRenderer := &fieldsRenderer{
 		templateFuncs: g.bodyTemplateFuncs(),
+		generateCfg:   g.generateCfg,
 	}
 
 	mapFieldsRenderer := &mapFieldsRenderer{
 		templateFuncs: g.bodyTemplateFuncs(),
+		generateCfg:   g.generateCfg,
 	}
 
 	g.outputObjectFieldRenderers = append(g.outputObjectFieldRenderers, fieldsRenderer, mapFieldsRenderer)
@@ -0,0 +1,89 @@
+package graphql
+
+// visible returns true, if element with tags is visible in schema, which selects schemaTags.
+// Untagged elements and all elements of schemas without tags are visible.
+func visible(tags, schemaTags []string) bool {
+	if len(tags) == 0 || len(schemaTags) == 0 {
+		return true
+	}
+	for _, tag := range tags {
+		for _, schemaTag := range schemaTags {
+			if tag == schemaTag {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// resolveHidden returns types, fields, arguments and enum values, which are hidden from schema, or nil if nothing is hidden.
+func (g *schemaParser) resolveHidden(objects []*gqlObject) *SchemaHidden {
+	tags := g.schemaCfg.Visibility
+	if len(tags) == 0 {
+		return nil
+	}
+	res := new(SchemaHidden)
+	for _, path := range TypesFilesPaths(g.types) {
+		typesFile := g.types[path]
+		for _, enum := range typesFile.Enums {
+			if !visible(enum.Visibility, tags) {
+				res.Types = append(res.Types, enum.GraphQLName)
+
+				continue
+			}
+			for _, value := range enum.Values {
+				if !visible(value.Visibility, tags) {
+					res.EnumValues = append(res.EnumValues, enum.GraphQLName+"."+value.Name)
+				}
+			}
+		}
+		for _, object := range typesFile.InputObjects {
+			res.addObject(object.GraphQLName, object.Visibility, tags, object.Fields)
+		}
+		for _, object := range typesFile.OutputObjects {
+			res.addObject(object.GraphQLName, object.Visibility, tags, object.Fields, object.MapFields)
+		}
+	}
+	for _, object := range objects {
+		for _, field := range object.Fields {
+			if field.Service == nil {
+				continue
+			}
+			service, _ := g.findServiceByName(field.Service.Name)
+			methods := service.MutationMethods
+			if object.QueryObject {
+				methods = service.QueryMethods
+			}
+			method := findMethod(methods, field.Name)
+			if method == nil {
+				continue
+			}
+			for _, arg := range method.Arguments {
+				if !visible(arg.Visibility, tags) {
+					res.Arguments = append(res.Arguments, object.Name+"."+field.Name+"."+arg.Name)
+				}
+			}
+		}
+	}
+	if len(res.Types)+len(res.Fields)+len(res.Arguments)+len(res.EnumValues) == 0 {
+		return nil
+	}
+
+	return res
+}
+
+func (h *SchemaHidden) addObject(name string, visibility, tags []string, fields ...[]ObjectField) {
+	if !visible(visibility, tags) {
+		h.Types = append(h.Types, name)
+
+		return
+	}
+	for _, objectFields := range fields {
+		for _, field := range objectFields {
+			if !visible(field.Visibility, tags) {
+				h.Fields = append(h.Fields, name+"."+field.Name)
+			}
+		}
+	}
+}
@@ -2,11 +2,14 @@ package proto2gql
 
 import (
 	"regexp"
+	"sort"
+	"strconv"
 	"time"
 
 	"github.com/pkg/errors"
 
 	"github.com/EGT-Ukraine/go2gql/generator/plugins/dataloader"
+	"github.com/EGT-Ukraine/go2gql/generator/plugins/graphql"
 )
 
 const (
@@ -20,7 +23,12 @@ const (
 )
 
 type FieldsConfig struct {
-	ContextKey string `mapstructure:"context_key"`
+	ContextKey  string   `mapstructure:"context_key"`
+	Visibility  []string `mapstructure:"visibility"`   // visibility tags, merged with field visibility option tags
+	Exclude     bool     `mapstructure:"exclude"`      // exclude field from GraphQL input and output objects
+	Name        string   `mapstructure:"name"`         // GraphQL field name, proto field name by default
+	Description string   `mapstructure:"description"`  // GraphQL field description, proto field comment by default
+	Int64Format string   `mapstructure:"int64_format"` // overrides file int64_format for 64-bit integer field
 }
 
 type MessageConfig struct {
@@ -28,20 +36,78 @@ type MessageConfig struct {
 	Fields      map[string]FieldsConfig  `mapstructure:"fields"`
 	DataLoaders []dataloader.FieldConfig `mapstructure:"data_loaders"`
 	UnwrapField bool                     `mapstructure:"unwrap_field"`
+	Node        *graphql.NodeConfig      `mapstructure:"node"`       // declares message as relay node
+	Visibility  []string                 `mapstructure:"visibility"` // visibility tags, merged with message visibility option tags
+}
+
+type EnumConfig struct {
+	Visibility       []string                   `mapstructure:"visibility"` // visibility tags, merged with enum visibility option tags
+	Values           map[string]EnumValueConfig `mapstructure:"values"`
+	ValuePrefix      string                     `mapstructure:"value_prefix"`       // overrides file enum_value_prefix
+	UnspecifiedValue string                     `mapstructure:"unspecified_value"`  // overrides file unspecified_enum_value
+	UnknownValue     string                     `mapstructure:"unknown_value"`      // fallback | report. Resolving of values, unknown to generated enum
+	UnknownValueName string                     `mapstructure:"unknown_value_name"` // name of fallback value. Default: UNKNOWN
+}
+
+type EnumValueConfig struct {
+	Visibility []string `mapstructure:"visibility"` // visibility tags, merged with enum value visibility option tags
+	Name       string   `mapstructure:"name"`       // GraphQL value name, which has priority over prefix stripping
 }
 
 type MethodConfig struct {
 	Alias              string                      `mapstructure:"alias"`
 	RequestType        string                      `mapstructure:"request_type"` // QUERY | MUTATION
 	DataLoaderProvider map[string]DataLoaderConfig `mapstructure:"data_loaders"`
+	ReadMaskField      string                      `mapstructure:"read_mask_field"`   // google.protobuf.FieldMask request field, filled from GraphQL selection set
+	UpdateMaskField    string                      `mapstructure:"update_mask_field"` // google.protobuf.FieldMask request field, filled from passed arguments
+	PrimeLoaders       []string                    `mapstructure:"prime_loaders"`     // 1-1 data loaders, which caches are primed with method result entities
+	Auth               *graphql.AuthConfig         `mapstructure:"auth"`              // authorization policy, checked by interceptors.Authorizer
+	Visibility         []string                    `mapstructure:"visibility"`        // visibility tags, merged with method visibility option tags
 }
 
 type DataLoaderConfig struct {
-	RequestField string        `mapstructure:"request_field"`
-	ResultField  string        `mapstructure:"result_field"`
-	MatchField   string        `mapstructure:"match_field"`
-	Type         string        `mapstructure:"type"`
-	WaitDuration time.Duration `mapstructure:"wait_duration"`
+	RequestField  string        `mapstructure:"request_field"`
+	RequestFields []string      `mapstructure:"request_fields"` // composite key request fields
+	ResultField   string        `mapstructure:"result_field"`
+	MatchField    string        `mapstructure:"match_field"`
+	MatchFields   []string      `mapstructure:"match_fields"` // composite key match fields
+	Type          string        `mapstructure:"type"`
+	WaitDuration  time.Duration `mapstructure:"wait_duration"`
+	MaxBatch      int           `mapstructure:"max_batch"`
+	Cache         string        `mapstructure:"cache"` // none | request | ttl
+	CacheTTL      time.Duration `mapstructure:"cache_ttl"`
+	Lazy          bool          `mapstructure:"lazy"`
+}
+
+func (c DataLoaderConfig) requestFields() []string {
+	if len(c.RequestFields) > 0 {
+		return c.RequestFields
+	}
+
+	return []string{c.RequestField}
+}
+
+func (c DataLoaderConfig) matchFields() []string {
+	if len(c.MatchFields) > 0 {
+		return c.MatchFields
+	}
+
+	return []string{c.MatchField}
+}
+
+func (c DataLoaderConfig) compositeKey() bool {
+	return len(c.matchFields()) > 1
+}
+
+// dataLoadersNames returns sorted names of data loaders, provided by method.
+func (c MethodConfig) dataLoadersNames() []string {
+	res := make([]string, 0, len(c.DataLoaderProvider))
+	for name := range c.DataLoaderProvider {
+		res = append(res, name)
+	}
+	sort.Strings(res)
+
+	return res
 }
 
 type ServiceConfig struct {
@@ -49,6 +115,17 @@ type ServiceConfig struct {
 	Methods     map[string]MethodConfig `mapstructure:"methods"`
 }
 
+// methodsNames returns sorted names of configured methods.
+func (c ServiceConfig) methodsNames() []string {
+	res := make([]string, 0, len(c.Methods))
+	for name := range c.Methods {
+		res = append(res, name)
+	}
+	sort.Strings(res)
+
+	return res
+}
+
 type Config struct {
 	Files []*ProtoFileConfig `mapstructure:"files"`
 
@@ -57,6 +134,8 @@ type Config struct {
 	OutputPath     string                     `mapstructure:"output_path"`
 	ImportsAliases []map[string]string        `mapstructure:"imports_aliases"`
 	Messages       []map[string]MessageConfig `mapstructure:"messages"`
+	FieldNaming    string                     `mapstructure:"field_naming"` // original|lowerCamel|json_name. Default: original
+	Int64Format    string                     `mapstructure:"int64_format"` // number|string. 64-bit integers serialization. Default: number
 }
 
 func (c *Config) GetOutputPath() string {
@@ -83,8 +162,15 @@ type ProtoFileConfig struct {
 	GQLEnumsPrefix   string `mapstructure:"gql_enums_prefix"`
 	GQLMessagePrefix string `mapstructure:"gql_messages_prefix"`
 
+	EnumValuePrefix      string `mapstructure:"enum_value_prefix"`      // strip_type_name or literal prefix, which is stripped from enums values names
+	UnspecifiedEnumValue string `mapstructure:"unspecified_enum_value"` // hide|null. Hides *UNSPECIFIED zero values of enums
+
+	FieldNaming string `mapstructure:"field_naming"` // overrides global field_naming for file
+	Int64Format string `mapstructure:"int64_format"` // overrides global int64_format for file
+
 	Services map[string]ServiceConfig   `mapstructure:"services"`
 	Messages []map[string]MessageConfig `mapstructure:"messages"`
+	Enums    []map[string]EnumConfig    `mapstructure:"enums"`
 }
 
 func (pc *ProtoFileConfig) MessageConfig(msgName string) (MessageConfig, error) {
@@ -92,7 +178,8 @@ func (pc *ProtoFileConfig) MessageConfig(msgName string) (MessageConfig, error)
 		return MessageConfig{}, nil
 	}
 	for _, cfgs := range pc.Messages {
-		for msgNameRegex, cfg := range cfgs {
+		for _, msgNameRegex := range messagesConfigsRegexes(cfgs) {
+			cfg := cfgs[msgNameRegex]
 			r, err := regexp.Compile(msgNameRegex)
 			if err != nil {
 				return MessageConfig{}, errors.Wrapf(err, "failed to compile message name regex '%s'", msgNameRegex)
@@ -106,6 +193,40 @@ func (pc *ProtoFileConfig) MessageConfig(msgName string) (MessageConfig, error)
 	return MessageConfig{}, nil
 }
 
+// fieldExcluded returns true, if message field must not be rendered to GraphQL objects.
+func (mc MessageConfig) fieldExcluded(fieldName string) bool {
+	return mc.Fields[fieldName].Exclude
+}
+
+// fieldQuotedComment returns quoted GraphQL description of message field.
+func (mc MessageConfig) fieldQuotedComment(fieldName, quotedComment string) string {
+	if description := mc.Fields[fieldName].Description; description != "" {
+		return strconv.Quote(description)
+	}
+
+	return quotedComment
+}
+
+// EnumConfig returns config of the first enums regex, which matches enum name.
+func (pc *ProtoFileConfig) EnumConfig(enumName string) (EnumConfig, error) {
+	if pc == nil {
+		return EnumConfig{}, nil
+	}
+	for _, cfgs := range pc.Enums {
+		for _, enumNameRegex := range enumsConfigsRegexes(cfgs) {
+			r, err := regexp.Compile(enumNameRegex)
+			if err != nil {
+				return EnumConfig{}, errors.Wrapf(err, "failed to compile enum name regex '%s'", enumNameRegex)
+			}
+			if r.MatchString(enumName) {
+				return cfgs[enumNameRegex], nil
+			}
+		}
+	}
+
+	return EnumConfig{}, nil
+}
+
 func (pc *ProtoFileConfig) GetName() string {
 	if pc == nil {
 		return ""
@@ -162,6 +283,22 @@ func (pc *ProtoFileConfig) GetGQLEnumsPrefix() string {
 	return pc.GQLEnumsPrefix
 }
 
+func (pc *ProtoFileConfig) GetEnumValuePrefix() string {
+	if pc == nil {
+		return ""
+	}
+
+	return pc.EnumValuePrefix
+}
+
+func (pc *ProtoFileConfig) GetUnspecifiedEnumValue() string {
+	if pc == nil {
+		return ""
+	}
+
+	return pc.UnspecifiedEnumValue
+}
+
 func (pc *ProtoFileConfig) GetGQLMessagePrefix() string {
 	if pc == nil {
 		return ""
@@ -186,6 +323,18 @@ func (pc *ProtoFileConfig) GetServices() map[string]ServiceConfig {
 	return pc.Services
 }
 
+// servicesNames returns sorted names of configured services.
+func (pc *ProtoFileConfig) servicesNames() []string {
+	services := pc.GetServices()
+	res := make([]string, 0, len(services))
+	for name := range services {
+		res = append(res, name)
+	}
+	sort.Strings(res)
+
+	return res
+}
+
 func (pc *ProtoFileConfig) GetMessages() []map[string]MessageConfig {
 	if pc == nil {
 		return []map[string]MessageConfig{}
@@ -217,3 +366,26 @@ type GenerateConfig struct {
 	Tracer     bool
 	VendorPath string
 }
+
+// enumsConfigsRegexes returns sorted enums names regexes of enums configs item.
+func enumsConfigsRegexes(cfgs map[string]EnumConfig) []string {
+	res := make([]string, 0, len(cfgs))
+	for regex := range cfgs {
+		res = append(res, regex)
+	}
+	sort.Strings(res)
+
+	return res
+}
+
+// messagesConfigsRegexes returns sorted messages names regexes of messages configs item,
+// so the first matched config doesn't depend on map iteration order.
+func messagesConfigsRegexes(cfgs map[string]MessageConfig) []string {
+	res := make([]string, 0, len(cfgs))
+	for regex := range cfgs {
+		res = append(res, regex)
+	}
+	sort.Strings(res)
+
+	return res
+}
@@ -0,0 +1,121 @@
+package proto2gql
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	"github.com/EGT-Ukraine/go2gql/generator"
+)
+
+// ValidateConfig reports messages and enums regexes, messages fields and enums values configs, which matched nothing in proto files.
+// Services and methods are validated during plugin preparation.
+func (p *Plugin) ValidateConfig() error {
+	var entries []string
+	for _, file := range p.parsedFiles {
+		if file.Config == nil {
+			continue
+		}
+		fileEntries, err := unmatchedMessagesConfigs(file)
+		if err != nil {
+			return errors.Wrapf(err, "failed to validate file %s config", file.File.FilePath)
+		}
+		entries = append(entries, fileEntries...)
+		fileEntries, err = unmatchedEnumsConfigs(file)
+		if err != nil {
+			return errors.Wrapf(err, "failed to validate file %s config", file.File.FilePath)
+		}
+		entries = append(entries, fileEntries...)
+	}
+	if len(entries) > 0 {
+		sort.Strings(entries)
+
+		return generator.UnmatchedConfigEntriesError{Entries: entries}
+	}
+
+	return nil
+}
+
+func unmatchedMessagesConfigs(file *parsedFile) ([]string, error) {
+	var res []string
+	for _, cfgs := range file.Config.Messages {
+		for _, msgNameRegex := range messagesConfigsRegexes(cfgs) {
+			cfg := cfgs[msgNameRegex]
+			r, err := regexp.Compile(msgNameRegex)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to compile message name regex '%s'", msgNameRegex)
+			}
+			matched := false
+			fields := make(map[string]struct{})
+			for _, msg := range file.File.Messages {
+				if !r.MatchString(msg.Name) {
+					continue
+				}
+				matched = true
+				for _, field := range msg.GetFields() {
+					fields[field.GetName()] = struct{}{}
+				}
+			}
+			if !matched {
+				res = append(res, fmt.Sprintf("%s: messages regex '%s' matched no messages", file.File.FilePath, msgNameRegex))
+
+				continue
+			}
+			var unmatchedFields []string
+			for fieldName := range cfg.Fields {
+				if _, ok := fields[fieldName]; !ok {
+					unmatchedFields = append(unmatchedFields, fieldName)
+				}
+			}
+			sort.Strings(unmatchedFields)
+			for _, fieldName := range unmatchedFields {
+				res = append(res, fmt.Sprintf("%s: field '%s' of messages regex '%s' matched no fields", file.File.FilePath, fieldName, msgNameRegex))
+			}
+		}
+	}
+
+	return res, nil
+}
+
+func unmatchedEnumsConfigs(file *parsedFile) ([]string, error) {
+	var res []string
+	for _, cfgs := range file.Config.Enums {
+		for _, enumNameRegex := range enumsConfigsRegexes(cfgs) {
+			cfg := cfgs[enumNameRegex]
+			r, err := regexp.Compile(enumNameRegex)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to compile enum name regex '%s'", enumNameRegex)
+			}
+			matched := false
+			values := make(map[string]struct{})
+			for _, enum := range file.File.Enums {
+				if !r.MatchString(enum.Name) {
+					continue
+				}
+				matched = true
+				for _, value := range enum.Values {
+					values[value.Name] = struct{}{}
+				}
+			}
+			if !matched {
+				res = append(res, fmt.Sprintf("%s: enums regex '%s' matched no enums", file.File.FilePath, enumNameRegex))
+
+				continue
+			}
+			var unmatchedValues []string
+			for valueName := range cfg.Values {
+				if _, ok := values[valueName]; !ok {
+					unmatchedValues = append(unmatchedValues, valueName)
+				}
+			}
+			sort.Strings(unmatchedValues)
+			for _, valueName := range unmatchedValues {
+				res = append(res, fmt.Sprintf("%s: value '%s' of enums regex '%s' matched no values", file.File.FilePath, valueName, enumNameRegex))
+			}
+		}
+	}
+
+	return res, nil
+}
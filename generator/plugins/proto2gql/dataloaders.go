@@ -13,8 +13,11 @@ import (
 )
 
 func (g Proto2GraphQL) registerMethodDataLoaders(sc ServiceConfig, cfg MethodConfig, file *parsedFile, method *parser.Method) error {
-	for name, cfg := range cfg.DataLoaderProvider {
-		if err := g.registerMethodDataLoader(name, cfg, sc, file, method); err != nil {
+	if cfg.Auth != nil && len(cfg.DataLoaderProvider) > 0 {
+		return errors.New("data loaders provider method can't have auth policy, because data loaders call method without interceptors")
+	}
+	for _, name := range cfg.dataLoadersNames() {
+		if err := g.registerMethodDataLoader(name, cfg.DataLoaderProvider[name], sc, file, method); err != nil {
 			return errors.Wrapf(err, "failed to register %s data loader", name)
 		}
 	}
@@ -40,11 +43,6 @@ func (g Proto2GraphQL) registerMethodDataLoader(name string, cfg DataLoaderConfi
 		return errors.Wrap(err, "failed to get result message file")
 	}
 
-	matchField, err := messageFieldByPath(resultField.GetType().(*parser.Message), cfg.MatchField)
-	if err != nil {
-		return errors.Wrap(err, "failed to get match field")
-	}
-
 	responseGoType, err := g.goTypeByParserType(normalResultField.Type)
 	if err != nil {
 		return errors.Wrap(err, "failed to get result field go type")
@@ -60,7 +58,7 @@ func (g Proto2GraphQL) registerMethodDataLoader(name string, cfg DataLoaderConfi
 		return errors.Wrap(err, "failed to resolve output type")
 	}
 
-	var fetchCode func(importer *importer.Importer) string
+	var fetchCode func(importer *importer.Importer, args []dataloader.Arg) string
 	if cfg.Type == DataLoaderType1ToN {
 		fetchCode, err = g.oneToNDataLoaderFetchCode(file, cfg, method)
 		if err != nil {
@@ -79,9 +77,27 @@ func (g Proto2GraphQL) registerMethodDataLoader(name string, cfg DataLoaderConfi
 		}
 	}
 
-	matchFieldGoType, err := g.goTypeByParserType(matchField.GetType())
-	if err != nil {
-		return errors.Wrap(err, "failed to resolve go type of match field")
+	var keyFields []dataloader.KeyField
+	for _, matchFieldPath := range cfg.matchFields() {
+		matchField, err := messageFieldByPath(resultMessage, matchFieldPath)
+		if err != nil {
+			return errors.Wrap(err, "failed to get match field")
+		}
+
+		matchFieldGoType, err := g.goTypeByParserType(matchField.GetType())
+		if err != nil {
+			return errors.Wrap(err, "failed to resolve go type of match field")
+		}
+
+		keyFields = append(keyFields, dataloader.KeyField{
+			Name:   dataLoaderKeyFieldName(matchFieldPath),
+			GoType: matchFieldGoType,
+		})
+	}
+
+	keyGoType := keyFields[0].GoType
+	if !cfg.compositeKey() {
+		keyFields = nil
 	}
 
 	dataLoaderProvider := dataloader.LoaderModel{
@@ -93,13 +109,25 @@ func (g Proto2GraphQL) registerMethodDataLoader(name string, cfg DataLoaderConfi
 		FetchCode: fetchCode,
 		InputGoType: graphql.GoType{
 			Kind:     reflect.Slice,
-			ElemType: &matchFieldGoType,
+			ElemType: &keyGoType,
 		},
 		OutputGoType:      responseGoType,
 		OutputGraphqlType: dataLoaderOutType,
 		Name:              name,
 		WaitDuration:      cfg.WaitDuration,
 		Slice:             cfg.Type == DataLoaderType1ToN,
+		MaxBatch:          cfg.MaxBatch,
+		Cache:             cfg.Cache,
+		CacheTTL:          cfg.CacheTTL,
+		Lazy:              cfg.Lazy,
+		KeyFields:         keyFields,
+		RequestArg:        g.dataLoaderRequestArg(cfg, method),
+	}
+
+	if cfg.Type == DataLoaderType1To1 {
+		dataLoaderProvider.MatchKey = func(value string, importer *importer.Importer) string {
+			return dataLoaderMatchKey(cfg, dataLoaderProvider.KeyTypeName(), value)
+		}
 	}
 
 	g.DataLoaderPlugin.AddLoader(dataLoaderProvider)
@@ -108,19 +136,15 @@ func (g Proto2GraphQL) registerMethodDataLoader(name string, cfg DataLoaderConfi
 }
 
 func (g Proto2GraphQL) validateDataLoader(cfg DataLoaderConfig, method *parser.Method) error {
-	if cfg.RequestField == "" {
-		return errors.New("empty request field")
-	}
-	requestField, err := messageFieldByPath(method.InputMessage, cfg.RequestField)
-	if err != nil {
-		return errors.Wrap(err, "failed to get message request field")
+	if cfg.RequestField != "" && len(cfg.RequestFields) > 0 {
+		return errors.New("request_field and request_fields can't be used together")
 	}
-	normalRequestField, ok := requestField.(*parser.NormalField)
-	if !ok {
-		return errors.New("request field should not be a map")
+	if cfg.MatchField != "" && len(cfg.MatchFields) > 0 {
+		return errors.New("match_field and match_fields can't be used together")
 	}
-	if !normalRequestField.Repeated {
-		return errors.New("request field should be repeated")
+	requestFields, matchFields := cfg.requestFields(), cfg.matchFields()
+	if len(requestFields) != len(matchFields) {
+		return errors.Errorf("request fields count(%d) must be equal to match fields count(%d)", len(requestFields), len(matchFields))
 	}
 
 	if cfg.ResultField == "" {
@@ -142,22 +166,50 @@ func (g Proto2GraphQL) validateDataLoader(cfg DataLoaderConfig, method *parser.M
 	}
 	resultMessage := normalResultField.Type.(*parser.Message)
 
-	if cfg.MatchField == "" {
-		return errors.New("empty match field")
-	}
-	matchField, err := messageFieldByPath(resultMessage, cfg.MatchField)
-	if err != nil {
-		return errors.Wrap(err, "failed to get message match field")
-	}
-	normalMatchField, ok := matchField.(*parser.NormalField)
-	if !ok {
-		return errors.New("match field should not be a map")
-	}
-	if normalMatchField.Type.Kind() != parser.TypeScalar {
-		return errors.New("match field should not be scalar")
-	}
-	if normalMatchField.Repeated {
-		return errors.New("match should not be repeated")
+	for i, requestFieldPath := range requestFields {
+		if requestFieldPath == "" {
+			return errors.New("empty request field")
+		}
+		requestField, err := messageFieldByPath(method.InputMessage, requestFieldPath)
+		if err != nil {
+			return errors.Wrap(err, "failed to get message request field")
+		}
+		normalRequestField, ok := requestField.(*parser.NormalField)
+		if !ok {
+			return errors.Errorf("request field %s should not be a map", requestFieldPath)
+		}
+		if !normalRequestField.Repeated {
+			return errors.Errorf("request field %s should be repeated", requestFieldPath)
+		}
+
+		if matchFields[i] == "" {
+			return errors.New("empty match field")
+		}
+		matchField, err := messageFieldByPath(resultMessage, matchFields[i])
+		if err != nil {
+			return errors.Wrap(err, "failed to get message match field")
+		}
+		normalMatchField, ok := matchField.(*parser.NormalField)
+		if !ok {
+			return errors.Errorf("match field %s should not be a map", matchFields[i])
+		}
+		if normalMatchField.Type.Kind() != parser.TypeScalar {
+			return errors.Errorf("match field %s should be scalar", matchFields[i])
+		}
+		if normalMatchField.Repeated {
+			return errors.Errorf("match field %s should not be repeated", matchFields[i])
+		}
+
+		requestScalar, ok := normalRequestField.Type.(*parser.Scalar)
+		if !ok || requestScalar.ScalarName != normalMatchField.Type.(*parser.Scalar).ScalarName {
+			return errors.Errorf(
+				"request field %s type(%s) must be same as match field %s type(%s)",
+				requestFieldPath,
+				normalRequestField.Type.String(),
+				matchFields[i],
+				normalMatchField.Type.String(),
+			)
+		}
 	}
 
 	switch cfg.Type {
@@ -169,7 +221,146 @@ func (g Proto2GraphQL) validateDataLoader(cfg DataLoaderConfig, method *parser.M
 	return nil
 }
 
-func (g Proto2GraphQL) oneToOneDataLoaderFetchCode(file *parsedFile, cfg DataLoaderConfig, method *parser.Method) (func(importer *importer.Importer) string, error) {
+// dataLoaderRequestArg returns resolver of request fields, which can be filled by data loader field arguments.
+func (g Proto2GraphQL) dataLoaderRequestArg(cfg DataLoaderConfig, method *parser.Method) func(requestField string) (*dataloader.Arg, error) {
+	return func(requestField string) (*dataloader.Arg, error) {
+		for _, keyRequestField := range cfg.requestFields() {
+			if keyRequestField == requestField {
+				return nil, errors.Errorf("request field %s is filled by data loader keys", requestField)
+			}
+		}
+
+		field, err := messageFieldByPath(method.InputMessage, requestField)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get request field")
+		}
+		normalField, ok := field.(*parser.NormalField)
+		if !ok || normalField.Repeated {
+			return nil, errors.Errorf("request field %s should not be repeated or map", requestField)
+		}
+		switch fieldType := normalField.Type.(type) {
+		case *parser.Scalar:
+			if fieldType.ScalarName == "bytes" {
+				return nil, errors.Errorf("request field %s should not be bytes", requestField)
+			}
+		case *parser.Enum:
+		default:
+			return nil, errors.Errorf("request field %s should be scalar or enum", requestField)
+		}
+
+		typeFile, err := g.parsedFile(normalField.Type.File())
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to resolve request field type parsed file")
+		}
+		goType, err := g.goTypeByParserType(normalField.Type)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to resolve request field go type")
+		}
+		gqlType, err := g.TypeInputGraphQLTypeResolver(typeFile, normalField.Type)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to resolve request field graphql type")
+		}
+		valueResolver, withErr, _, err := g.TypeValueResolver(typeFile, normalField.Type, "", false)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to resolve request field value resolver")
+		}
+
+		return &dataloader.Arg{
+			Name:         dataLoaderKeyFieldName(requestField),
+			RequestField: requestField,
+			GoType:       goType,
+			GraphQLType:  gqlType,
+			Value:        valueResolver,
+			WithErr:      withErr,
+		}, nil
+	}
+}
+
+// dataLoaderKeyFieldName returns name of composite key struct field.
+func dataLoaderKeyFieldName(path string) string {
+	return camelCase(strings.Replace(path, ".", "_", -1))
+}
+
+// dataLoaderRequest returns code, which prepares keys for request, and request message.
+// Arguments fields are filled from `args` struct.
+func (g Proto2GraphQL) dataLoaderRequest(file *parsedFile, importer *importer.Importer, cfg DataLoaderConfig, method *parser.Method, args []dataloader.Arg) (prepare, request string, err error) {
+	var argsPaths, argsValues []string
+	for _, arg := range args {
+		argsPaths = append(argsPaths, arg.RequestField)
+		argsValues = append(argsValues, "args."+arg.Name)
+	}
+
+	if !cfg.compositeKey() {
+		request, err = g.getMessageWithFilledFields(
+			file,
+			importer,
+			method.InputMessage,
+			append([]string{cfg.RequestField}, argsPaths...),
+			append([]string{"keys"}, argsValues...),
+		)
+
+		return "", request, err
+	}
+
+	var values []string
+	var fill string
+	for i, requestFieldPath := range cfg.requestFields() {
+		requestField, err := messageFieldByPath(method.InputMessage, requestFieldPath)
+		if err != nil {
+			return "", "", errors.Wrap(err, "failed to get request field")
+		}
+		goType, err := g.goTypeByParserType(requestField.GetType())
+		if err != nil {
+			return "", "", errors.Wrap(err, "failed to resolve request field go type")
+		}
+		keyFieldName := dataLoaderKeyFieldName(cfg.matchFields()[i])
+		value := "keys" + keyFieldName
+		prepare += value + " := make([]" + goType.String(importer) + ", len(keys))\n"
+		fill += value + "[i] = key." + keyFieldName + "\n"
+		values = append(values, value)
+	}
+	prepare += "for i, key := range keys {\n" + fill + "}\n"
+
+	request, err = g.getMessageWithFilledFields(
+		file,
+		importer,
+		method.InputMessage,
+		append(append([]string{}, cfg.requestFields()...), argsPaths...),
+		append(values, argsValues...),
+	)
+
+	return prepare, request, err
+}
+
+// dataLoaderMatchCondition returns condition, which checks, that result `value` matches `key`.
+func dataLoaderMatchCondition(cfg DataLoaderConfig) string {
+	if !cfg.compositeKey() {
+		return "value." + buildFieldGetterByFieldPath(cfg.MatchField) + " == key"
+	}
+
+	var conditions []string
+	for _, matchField := range cfg.matchFields() {
+		conditions = append(conditions, "value."+buildFieldGetterByFieldPath(matchField)+" == key."+dataLoaderKeyFieldName(matchField))
+	}
+
+	return strings.Join(conditions, " && ")
+}
+
+// dataLoaderMatchKey returns key of result `value`.
+func dataLoaderMatchKey(cfg DataLoaderConfig, keyTypeName, value string) string {
+	if !cfg.compositeKey() {
+		return value + "." + buildFieldGetterByFieldPath(cfg.MatchField)
+	}
+
+	var fields []string
+	for _, matchField := range cfg.matchFields() {
+		fields = append(fields, dataLoaderKeyFieldName(matchField)+": "+value+"."+buildFieldGetterByFieldPath(matchField))
+	}
+
+	return keyTypeName + "{" + strings.Join(fields, ", ") + "}"
+}
+
+func (g Proto2GraphQL) oneToOneDataLoaderFetchCode(file *parsedFile, cfg DataLoaderConfig, method *parser.Method) (func(importer *importer.Importer, args []dataloader.Arg) string, error) {
 	resultField, err := messageFieldByPath(method.OutputMessage, cfg.ResultField)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get result message field by path")
@@ -179,23 +370,22 @@ func (g Proto2GraphQL) oneToOneDataLoaderFetchCode(file *parsedFile, cfg DataLoa
 		return nil, errors.Wrap(err, "failed to resolve go type by result field parser type")
 	}
 
-	return func(importer *importer.Importer) string {
-		filledRequest, err := g.getMessageWithFilledField(file, importer, method.InputMessage, strings.Split(cfg.RequestField, "."), "keys")
+	return func(importer *importer.Importer, args []dataloader.Arg) string {
+		prepareRequest, filledRequest, err := g.dataLoaderRequest(file, importer, cfg, method, args)
 		if err != nil {
 			panic("failed to build request message")
 		}
 
 		responseFieldAccessor := buildFieldAccessorByFieldPath(cfg.ResultField)
-		matchFieldAccessor := buildFieldAccessorByFieldPath(cfg.MatchField)
 
-		return `response, err := client.` + method.Name + `(ctx, ` + filledRequest + `)
+		return prepareRequest + `response, err := client.` + method.Name + `(ctx, ` + filledRequest + `)
 				if err != nil{
 					return nil, []error{err}
 				}
 				var result = make([]` + responseGoType.String(importer) + `, len(keys))
 				for i, key := range keys {
 				    for _, value := range response.` + responseFieldAccessor + ` {
-				        if value.` + matchFieldAccessor + ` == key {
+				        if ` + dataLoaderMatchCondition(cfg) + ` {
 				            result[i] = value
 							break
 				        }
@@ -206,7 +396,7 @@ func (g Proto2GraphQL) oneToOneDataLoaderFetchCode(file *parsedFile, cfg DataLoa
 	}, nil
 }
 
-func (g Proto2GraphQL) oneToNDataLoaderFetchCode(file *parsedFile, cfg DataLoaderConfig, method *parser.Method) (func(importer *importer.Importer) string, error) {
+func (g Proto2GraphQL) oneToNDataLoaderFetchCode(file *parsedFile, cfg DataLoaderConfig, method *parser.Method) (func(importer *importer.Importer, args []dataloader.Arg) string, error) {
 	resultField, err := messageFieldByPath(method.OutputMessage, cfg.ResultField)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get result message field by path")
@@ -216,23 +406,22 @@ func (g Proto2GraphQL) oneToNDataLoaderFetchCode(file *parsedFile, cfg DataLoade
 		return nil, errors.Wrap(err, "failed to resolve go type by result field parser type")
 	}
 
-	return func(importer *importer.Importer) string {
-		filledRequest, err := g.getMessageWithFilledField(file, importer, method.InputMessage, strings.Split(cfg.RequestField, "."), "keys")
+	return func(importer *importer.Importer, args []dataloader.Arg) string {
+		prepareRequest, filledRequest, err := g.dataLoaderRequest(file, importer, cfg, method, args)
 		if err != nil {
 			panic("failed to build request message")
 		}
 
 		responseFieldAccessor := buildFieldAccessorByFieldPath(cfg.ResultField)
-		matchFieldAccessor := buildFieldAccessorByFieldPath(cfg.MatchField)
 
-		return `response, err := client.` + method.Name + `(ctx, ` + filledRequest + `)
+		return prepareRequest + `response, err := client.` + method.Name + `(ctx, ` + filledRequest + `)
 				if err != nil{
 					return nil, []error{err}
 				}
 				var result = make([][]` + responseGoType.String(importer) + `, len(keys))
 				for i, key := range keys {
 				    for _, value := range response.` + responseFieldAccessor + ` {
-				        if value.` + matchFieldAccessor + ` == key {
+				        if ` + dataLoaderMatchCondition(cfg) + ` {
 				            result[i] = append(result[i], value)
 				        }
 				    }
@@ -281,33 +470,163 @@ func buildFieldAccessorByFieldPath(path string) string {
 	return strings.Join(pathParts, ".")
 }
 
-func (g Proto2GraphQL) getMessageWithFilledField(file *parsedFile, importer *importer.Importer, msg *parser.Message, pathParts []string, value string) (string, error) {
-
-	field, ok := msg.GetFieldByName(pathParts[0])
-	if !ok {
-		return "", errors.New("can't find field ")
-	}
+// buildFieldGetterByFieldPath returns nil safe accessor of nested field.
+func buildFieldGetterByFieldPath(path string) string {
+	pathParts := strings.Split(path, ".")
 	if len(pathParts) == 1 {
-		typ, err := g.goTypeByParserType(msg)
-		if err != nil {
-			return "", errors.Wrap(err, "failed to resolve go type by parser type")
-		}
-
-		return g.buildMessageWithFilledField(file, typ.ElemType.String(importer), importer, camelCase(field.GetName()), value), nil
+		return camelCase(path)
 	}
-	fieldMsg := field.GetType().(*parser.Message)
-	res, err := g.getMessageWithFilledField(file, importer, fieldMsg, pathParts[1:], value)
-	if err != nil {
-		return "", errors.Wrapf(err, "failed to generate message %s with filled %v", fieldMsg.Name, pathParts[1:])
+	for i, part := range pathParts {
+		pathParts[i] = "Get" + camelCase(part) + "()"
 	}
+
+	return strings.Join(pathParts, ".")
+}
+
+// getMessageWithFilledFields returns message literal with fields, filled by values.
+// Fields paths may be nested (separated by dot).
+func (g Proto2GraphQL) getMessageWithFilledFields(file *parsedFile, importer *importer.Importer, msg *parser.Message, paths, values []string) (string, error) {
 	typ, err := g.goTypeByParserType(msg)
 	if err != nil {
 		return "", errors.Wrap(err, "failed to resolve go type by parser type")
 	}
 
-	return g.buildMessageWithFilledField(file, typ.ElemType.String(importer), importer, camelCase(field.GetName()), res), nil
+	var fieldsOrder []string
+	nestedPaths := make(map[string][]string)
+	nestedValues := make(map[string][]string)
+	fieldsValues := make(map[string]string)
+	for i, path := range paths {
+		pathParts := strings.SplitN(path, ".", 2)
+		field, ok := msg.GetFieldByName(pathParts[0])
+		if !ok {
+			return "", errors.Errorf("can't find field %s in message %s", pathParts[0], msg.Name)
+		}
+		if _, ok := fieldsValues[field.GetName()]; !ok {
+			if _, ok := nestedPaths[field.GetName()]; !ok {
+				fieldsOrder = append(fieldsOrder, field.GetName())
+			}
+		}
+		if len(pathParts) == 1 {
+			fieldsValues[field.GetName()] = values[i]
+			continue
+		}
+		nestedPaths[field.GetName()] = append(nestedPaths[field.GetName()], pathParts[1])
+		nestedValues[field.GetName()] = append(nestedValues[field.GetName()], values[i])
+	}
+
+	var fields []string
+	for _, fieldName := range fieldsOrder {
+		value, ok := fieldsValues[fieldName]
+		if !ok {
+			field, _ := msg.GetFieldByName(fieldName)
+			fieldMsg, ok := field.GetType().(*parser.Message)
+			if !ok {
+				return "", errors.Errorf("field %s of message %s is not a message", fieldName, msg.Name)
+			}
+			value, err = g.getMessageWithFilledFields(file, importer, fieldMsg, nestedPaths[fieldName], nestedValues[fieldName])
+			if err != nil {
+				return "", errors.Wrapf(err, "failed to generate message %s with filled %v", fieldMsg.Name, nestedPaths[fieldName])
+			}
+		}
+		fields = append(fields, camelCase(fieldName)+": "+value)
+	}
+
+	return `&` + typ.ElemType.String(importer) + `{` + strings.Join(fields, ", ") + `}`, nil
 }
 
-func (g Proto2GraphQL) buildMessageWithFilledField(file *parsedFile, typ string, importer *importer.Importer, field, value string) string {
-	return `&` + typ + `{` + field + `: ` + value + `}`
+// dataLoaderConfigByName returns data loader config and method, which provides data loader.
+func (g Proto2GraphQL) dataLoaderConfigByName(name string) (*DataLoaderConfig, *parser.Method, error) {
+	for _, file := range g.ParsedFiles {
+		if file.Config == nil {
+			continue
+		}
+		for _, serviceName := range file.Config.servicesNames() {
+			serviceConfig := file.Config.Services[serviceName]
+			for _, methodName := range serviceConfig.methodsNames() {
+				methodConfig := serviceConfig.Methods[methodName]
+				cfg, ok := methodConfig.DataLoaderProvider[name]
+				if !ok {
+					continue
+				}
+				service, ok := file.File.Services[serviceName]
+				if !ok {
+					return nil, nil, errors.Errorf("service %s not found", serviceName)
+				}
+				method, ok := service.Methods[methodName]
+				if !ok {
+					return nil, nil, errors.Errorf("method %s not found in service %s", methodName, serviceName)
+				}
+
+				return &cfg, method, nil
+			}
+		}
+	}
+
+	return nil, nil, errors.Errorf("data loader %s not found", name)
+}
+
+// primeLoadersClientMethodCaller returns client method caller, which primes data loaders with method result entities.
+func (g Proto2GraphQL) primeLoadersClientMethodCaller(cfg MethodConfig, method *parser.Method) (func(client, arg string, ctx graphql.BodyContext) string, error) {
+	var primes []string
+	for _, loaderName := range cfg.PrimeLoaders {
+		loaderCfg, loaderMethod, err := g.dataLoaderConfigByName(loaderName)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to resolve primed data loader")
+		}
+		if loaderCfg.Type != DataLoaderType1To1 {
+			return nil, errors.Errorf("data loader %s can't be primed. Only 1-1 loaders can be primed", loaderName)
+		}
+		resultField, err := messageFieldByPath(loaderMethod.OutputMessage, loaderCfg.ResultField)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get data loader %s result field", loaderName)
+		}
+		resultMessage, ok := resultField.GetType().(*parser.Message)
+		if !ok {
+			return nil, errors.Errorf("data loader %s result field should be of message type", loaderName)
+		}
+
+		var values []string
+		if method.OutputMessage.GetFullName() == resultMessage.GetFullName() {
+			values = append(values, "res")
+		}
+		for _, fld := range method.OutputMessage.NormalFields {
+			fldMsg, ok := fld.Type.(*parser.Message)
+			if !ok || fldMsg.GetFullName() != resultMessage.GetFullName() {
+				continue
+			}
+			if fld.Repeated {
+				values = append(values, "res."+camelCase(fld.Name)+"...")
+			} else {
+				values = append(values, "res."+camelCase(fld.Name))
+			}
+		}
+		if len(values) == 0 {
+			return nil, errors.Errorf("method output message %s doesn't contain data loader %s entities(%s)", method.OutputMessage.Name, loaderName, resultMessage.Name)
+		}
+
+		for _, value := range values {
+			primes = append(primes, "dataLoaders.Prime"+loaderName+"("+value+")")
+		}
+
+		g.DataLoaderPlugin.PrimeLoader(loaderName)
+	}
+
+	outputGoType, err := g.goTypeByParserType(method.OutputMessage)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to resolve output message go type")
+	}
+
+	return func(client, arg string, ctx graphql.BodyContext) string {
+		return `func() (` + outputGoType.String(ctx.Importer) + `, error) {
+			res, err := ` + client + "." + camelCase(method.Name) + `(ctx, ` + arg + `)
+			if err != nil {
+				return nil, err
+			}
+			if dataLoaders := ` + ctx.Importer.New(g.DataLoaderPlugin.LoadersPkg()) + `.GetDataLoadersFromContext(ctx); dataLoaders != nil {
+				` + strings.Join(primes, "\n") + `
+			}
+
+			return res, nil
+		}()`
+	}, nil
 }
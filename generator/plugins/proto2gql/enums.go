@@ -1,10 +1,28 @@
 package proto2gql
 
 import (
+	"strings"
+	"unicode"
+
+	"github.com/pkg/errors"
+
 	"github.com/EGT-Ukraine/go2gql/generator/plugins/graphql"
 	"github.com/EGT-Ukraine/go2gql/generator/plugins/proto2gql/parser"
 )
 
+const (
+	// EnumValuePrefixTypeName strips UPPER_SNAKE_CASE enum name prefix from values names. E.g. ORDER_STATUS_ of OrderStatus.
+	EnumValuePrefixTypeName = "strip_type_name"
+
+	UnspecifiedEnumValueHide = "hide" // zero *UNSPECIFIED value is removed from GraphQL enum and is serialized as null
+	UnspecifiedEnumValueNull = "null" // same as hide, but repeated enum fields lists elements are nullable too
+
+	UnknownEnumValueFallback = "fallback" // values, unknown to generated enum, are resolved to fallback value
+	UnknownEnumValueReport   = "report"   // values, unknown to generated enum, are reported to enums.Extension
+
+	defaultUnknownEnumValueName = "UNKNOWN"
+)
+
 func (g *Proto2GraphQL) enumTypeResolver(enumFile *parsedFile, enum *parser.Enum) (graphql.TypeResolver, error) {
 	return func(ctx graphql.BodyContext) string {
 		return ctx.Importer.Prefix(enumFile.OutputPkg) + g.enumVariable(enumFile, enum)
@@ -19,24 +37,124 @@ func (g *Proto2GraphQL) enumVariable(enumFile *parsedFile, enum *parser.Enum) st
 	return enumFile.Config.GetGQLEnumsPrefix() + camelCaseSlice(enum.TypeName)
 }
 
-func (g *Proto2GraphQL) prepareFileEnums(file *parsedFile) []graphql.Enum {
+// enumUnspecifiedValue returns unspecified value mode of enum.
+func (g *Proto2GraphQL) enumUnspecifiedValue(enum *parser.Enum) (string, error) {
+	fileCfg := g.fileConfig(enum.File())
+	cfg, err := fileCfg.EnumConfig(enum.Name)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to resolve enum %s config", enum.Name)
+	}
+	mode := cfg.UnspecifiedValue
+	if mode == "" {
+		mode = fileCfg.GetUnspecifiedEnumValue()
+	}
+	switch mode {
+	case "", UnspecifiedEnumValueHide, UnspecifiedEnumValueNull:
+		return mode, nil
+	}
+
+	return "", errors.Errorf("unknown enum %s unspecified value mode '%s'. Allowed values: %s, %s", enum.Name, mode, UnspecifiedEnumValueHide, UnspecifiedEnumValueNull)
+}
+
+// enumUnknownValue returns unknown values resolving mode of enum and name of fallback value.
+func (g *Proto2GraphQL) enumUnknownValue(enum *parser.Enum) (mode, name string, err error) {
+	cfg, err := g.fileConfig(enum.File()).EnumConfig(enum.Name)
+	if err != nil {
+		return "", "", errors.Wrapf(err, "failed to resolve enum %s config", enum.Name)
+	}
+	switch cfg.UnknownValue {
+	case "", UnknownEnumValueReport:
+		return cfg.UnknownValue, "", nil
+	case UnknownEnumValueFallback:
+		name = cfg.UnknownValueName
+		if name == "" {
+			name = defaultUnknownEnumValueName
+		}
+
+		return cfg.UnknownValue, name, nil
+	}
+
+	return "", "", errors.Errorf("unknown enum %s unknown value mode '%s'. Allowed values: %s, %s", enum.Name, cfg.UnknownValue, UnknownEnumValueFallback, UnknownEnumValueReport)
+}
+
+// enumValueName returns GraphQL name of enum value.
+func enumValueName(enum *parser.Enum, cfg EnumConfig, prefix string, value *parser.EnumValue) (string, error) {
+	if name := cfg.Values[value.Name].Name; name != "" {
+		return name, nil
+	}
+	if prefix == EnumValuePrefixTypeName {
+		prefix = upperSnakeCase(enum.Name) + "_"
+	}
+	if prefix == "" || !strings.HasPrefix(value.Name, prefix) || value.Name == prefix {
+		return value.Name, nil
+	}
+	name := strings.TrimPrefix(value.Name, prefix)
+	if unicode.IsDigit(rune(name[0])) {
+		return "", errors.Errorf("enum %s value %s without prefix '%s' is not valid GraphQL name. Rename it with values config", enum.Name, value.Name, prefix)
+	}
+
+	return name, nil
+}
+
+func isUnspecifiedEnumValue(value *parser.EnumValue) bool {
+	return value.Value == 0 && (value.Name == "UNSPECIFIED" || strings.HasSuffix(value.Name, "_UNSPECIFIED"))
+}
+
+func (g *Proto2GraphQL) prepareFileEnums(file *parsedFile) ([]graphql.Enum, error) {
 	var res []graphql.Enum
 	for _, enum := range file.File.Enums {
-		vals := make([]graphql.EnumValue, len(enum.Values))
-		for i, value := range enum.Values {
-			vals[i] = graphql.EnumValue{
-				Name:    value.Name,
-				Value:   value.Value,
-				Comment: value.QuotedComment,
+		cfg, err := file.Config.EnumConfig(enum.Name)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to resolve enum %s config", enum.Name)
+		}
+		unspecifiedValue, err := g.enumUnspecifiedValue(enum)
+		if err != nil {
+			return nil, err
+		}
+		prefix := cfg.ValuePrefix
+		if prefix == "" {
+			prefix = file.Config.GetEnumValuePrefix()
+		}
+		_, unknownValue, err := g.enumUnknownValue(enum)
+		if err != nil {
+			return nil, err
+		}
+		var vals []graphql.EnumValue
+		var names []string
+		for _, value := range enum.Values {
+			if unspecifiedValue != "" && isUnspecifiedEnumValue(value) {
+				continue
 			}
+			name, err := enumValueName(enum, cfg, prefix, value)
+			if err != nil {
+				return nil, err
+			}
+			vals = append(vals, graphql.EnumValue{
+				Name:       name,
+				Value:      value.Value,
+				Comment:    value.QuotedComment,
+				Visibility: mergeTags(value.Visibility, cfg.Values[value.Name].Visibility),
+			})
+			names = append(names, name)
+		}
+		if len(vals) == 0 {
+			return nil, errors.Errorf("enum %s has no values left after hiding unspecified value", enum.Name)
+		}
+		if unknownValue != "" {
+			names = append(names, unknownValue)
+		}
+		if err := checkNamesCollisions("enum "+g.enumGraphQLName(file, enum), "values", names); err != nil {
+			return nil, err
 		}
 		res = append(res, graphql.Enum{
 			VariableName: g.enumVariable(file, enum),
 			GraphQLName:  g.enumGraphQLName(file, enum),
 			Comment:      enum.QuotedComment,
 			Values:       vals,
+			Visibility:   mergeTags(enum.Visibility, cfg.Visibility),
+			UnknownValue: unknownValue,
 		})
 	}
 
-	return res
+	return res, nil
 }
@@ -0,0 +1,458 @@
+package proto2gql_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	. "github.com/smartystreets/goconvey/convey"
+	yaml "gopkg.in/yaml.v2"
+
+	"github.com/EGT-Ukraine/go2gql/generator"
+	"github.com/EGT-Ukraine/go2gql/generator/plugins/dataloader"
+	"github.com/EGT-Ukraine/go2gql/generator/plugins/graphql"
+	"github.com/EGT-Ukraine/go2gql/generator/plugins/proto2gql"
+)
+
+const testdataDir = "../../../testdata"
+
+// generated is an in memory generation result.
+type generated struct {
+	files map[string]string             // files contents by path
+	types map[string]*graphql.TypesFile // parsed types files by path
+}
+
+// configSplice replaces the first old part of config with new one.
+type configSplice struct {
+	old, new string
+}
+
+// testdataConfig returns contents of generate.yml in dir.
+func testdataConfig(dir string) string {
+	cfg, err := ioutil.ReadFile(filepath.Join(dir, "generate.yml"))
+	So(err, ShouldBeNil)
+
+	return string(cfg)
+}
+
+// generateTestdata generates cfg in memory in dir, as go2gql generates config in its directory.
+// Splices are applied to cfg before generation. Test fails, if cfg doesn't contain spliced part.
+func generateTestdata(dir, cfg string, splices ...configSplice) (*generated, error) {
+	for _, splice := range splices {
+		So(cfg, ShouldContainSubstring, splice.old)
+		cfg = strings.Replace(cfg, splice.old, splice.new, 1)
+	}
+
+	wd, err := os.Getwd()
+	So(err, ShouldBeNil)
+	So(os.Chdir(dir), ShouldBeNil)
+	defer os.Chdir(wd) //nolint:errcheck
+
+	return generateConfig([]byte(cfg))
+}
+
+// generateConfig generates files of config in memory.
+func generateConfig(cfg []byte) (*generated, error) {
+	gc := &generator.GenerateConfig{
+		Path:   "generate.yml",
+		Source: cfg,
+	}
+	if err := yaml.Unmarshal(cfg, gc); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal config")
+	}
+
+	gqlPlugin := new(graphql.Plugin)
+	g := &generator.Generator{Config: gc}
+	for _, plugin := range []generator.Plugin{gqlPlugin, new(dataloader.Plugin), new(proto2gql.Plugin)} {
+		if err := g.RegisterPlugin(plugin); err != nil {
+			return nil, err
+		}
+	}
+	if err := g.Init(); err != nil {
+		return nil, err
+	}
+	if err := g.Prepare(); err != nil {
+		return nil, err
+	}
+
+	output, err := generator.NewDryRunOutput(nil)
+	if err != nil {
+		return nil, err
+	}
+	if err := g.GenerateOutput(output); err != nil {
+		return nil, err
+	}
+
+	res := &generated{
+		files: make(map[string]string),
+		types: gqlPlugin.Types(),
+	}
+	for _, file := range output.Files() {
+		res.files[file.Path] = string(file.Content)
+	}
+
+	return res, nil
+}
+
+func findOutputObject(file *graphql.TypesFile, name string) *graphql.OutputObject {
+	for i := range file.OutputObjects {
+		if file.OutputObjects[i].GraphQLName == name {
+			return &file.OutputObjects[i]
+		}
+	}
+
+	return nil
+}
+
+func findInputObject(file *graphql.TypesFile, name string) *graphql.InputObject {
+	for i := range file.InputObjects {
+		if file.InputObjects[i].GraphQLName == name {
+			return &file.InputObjects[i]
+		}
+	}
+
+	return nil
+}
+
+func findEnum(file *graphql.TypesFile, name string) *graphql.Enum {
+	for i := range file.Enums {
+		if file.Enums[i].GraphQLName == name {
+			return &file.Enums[i]
+		}
+	}
+
+	return nil
+}
+
+func findField(fields []graphql.ObjectField, name string) *graphql.ObjectField {
+	for i := range fields {
+		if fields[i].Name == name {
+			return &fields[i]
+		}
+	}
+
+	return nil
+}
+
+func TestGenerateIsDeterministic(t *testing.T) {
+	Convey("Test testdata generation is deterministic", t, func() {
+		cfg := testdataConfig(testdataDir)
+
+		first, err := generateTestdata(testdataDir, cfg)
+		So(err, ShouldBeNil)
+		So(first.files, ShouldNotBeEmpty)
+
+		second, err := generateTestdata(testdataDir, cfg)
+		So(err, ShouldBeNil)
+		So(second.files, ShouldResemble, first.files)
+	})
+}
+
+func TestMessageFieldsConfigs(t *testing.T) {
+	Convey("Test message fields exclusion, renaming and description overriding", t, func() {
+		res, err := generateTestdata(testdataDir, testdataConfig(testdataDir), configSplice{
+			old: "              ctx_map_enum: {context_key: \"ctx_map_enum\"}\n",
+			new: "              ctx_map_enum: {context_key: \"ctx_map_enum\"}\n" +
+				"              r_scalar: {exclude: true}\n" +
+				"              map_scalar: {exclude: true}\n" +
+				"              n_r_scalar: {name: \"scalar\", description: \"Overridden description\"}\n",
+		})
+		So(err, ShouldBeNil)
+		file := res.types["out/test/test.go"]
+		So(file, ShouldNotBeNil)
+		output := findOutputObject(file, "ExmplRootMessage")
+		So(output, ShouldNotBeNil)
+		input := findInputObject(file, "ExmplRootMessageInput")
+		So(input, ShouldNotBeNil)
+
+		Convey("Excluded fields should not be parsed", func() {
+			So(findField(output.Fields, "r_scalar"), ShouldBeNil)
+			So(findField(output.MapFields, "map_scalar"), ShouldBeNil)
+			So(findField(input.Fields, "r_scalar"), ShouldBeNil)
+			So(findField(input.Fields, "map_scalar"), ShouldBeNil)
+		})
+		Convey("Renamed field should be parsed with new name and description", func() {
+			So(findField(output.Fields, "n_r_scalar"), ShouldBeNil)
+			So(findField(input.Fields, "n_r_scalar"), ShouldBeNil)
+			So(findField(output.Fields, "scalar"), ShouldNotBeNil)
+			So(findField(output.Fields, "scalar").QuotedComment, ShouldEqual, `"Overridden description"`)
+			So(findField(input.Fields, "scalar"), ShouldNotBeNil)
+		})
+		Convey("Renamed field should be resolved with new name", func() {
+			So(res.files["out/test/test.go"], ShouldContainSubstring, `args["scalar"]`)
+		})
+	})
+}
+
+func TestFieldNaming(t *testing.T) {
+	Convey("Test fields naming strategy", t, func() {
+		lowerCamel := configSplice{
+			old: "      gql_enums_prefix: \"Exmpl\"\n",
+			new: "      gql_enums_prefix: \"Exmpl\"\n      field_naming: \"lowerCamel\"\n",
+		}
+
+		Convey("Fields and methods should be renamed, but resolved by proto names", func() {
+			res, err := generateTestdata(testdataDir, testdataConfig(testdataDir), lowerCamel)
+			So(err, ShouldBeNil)
+			file := res.types["out/test/test.go"]
+			So(file, ShouldNotBeNil)
+			So(findField(findOutputObject(file, "ExmplRootMessage").Fields, "nRScalar"), ShouldNotBeNil)
+			So(findField(findOutputObject(file, "ExmplRootMessage").Fields, "n_r_scalar"), ShouldBeNil)
+			So(findField(findInputObject(file, "ExmplRootMessageInput").Fields, "nRScalar"), ShouldNotBeNil)
+			So(res.files["out/test/test.go"], ShouldContainSubstring, `result.NRScalar = args["nRScalar"]`)
+			So(res.files["out/test/test.go"], ShouldContainSubstring, `"emptyMsgs": &graphql.Field{`)
+		})
+		Convey("Names collisions should be reported", func() {
+			_, err := generateTestdata(testdataDir, testdataConfig(testdataDir), lowerCamel, configSplice{
+				old: "              ctx_map_enum: {context_key: \"ctx_map_enum\"}\n",
+				new: "              ctx_map_enum: {context_key: \"ctx_map_enum\"}\n              r_scalar: {name: \"nRScalar\"}\n",
+			})
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldContainSubstring, "several fields with GraphQL name 'nRScalar'")
+		})
+	})
+}
+
+func TestEnumsValuesNaming(t *testing.T) {
+	Convey("Test enums values prefix stripping, renaming and unspecified values", t, func() {
+		cfg := `
+proto2gql:
+  files:
+    - proto_path: "./enums.proto"
+      output_path: "./out/enums"
+      output_package: "enums"
+      enum_value_prefix: "strip_type_name"
+      unspecified_enum_value: "hide"
+      enums:
+        - "^OrderStatus$":
+            values:
+              ORDER_STATUS_CANCELED: {name: "CANCELLED"}
+        - "^HTTPMethod$":
+            unspecified_value: "null"
+`
+		res, err := generateTestdata(testdataDir, cfg)
+		So(err, ShouldBeNil)
+		file := res.types["out/enums/enums.go"]
+		So(file, ShouldNotBeNil)
+		orderStatus := findEnum(file, "OrderStatus")
+		So(orderStatus, ShouldNotBeNil)
+		httpMethod := findEnum(file, "HTTPMethod")
+		So(httpMethod, ShouldNotBeNil)
+		types := res.files["out/enums/enums.go"]
+
+		Convey("Prefixes should be stripped and values should keep proto numbers", func() {
+			So(orderStatus.Values[0].Name, ShouldEqual, "PENDING")
+			So(orderStatus.Values[0].Value, ShouldEqual, 1)
+			So(httpMethod.Values[0].Name, ShouldEqual, "GET")
+			So(httpMethod.Values[0].Value, ShouldEqual, 1)
+			So(types, ShouldNotContainSubstring, `ORDER_STATUS_`)
+		})
+		Convey("Configured value name should have priority", func() {
+			So(orderStatus.Values[2].Name, ShouldEqual, "CANCELLED")
+			So(orderStatus.Values[2].Value, ShouldEqual, 3)
+		})
+		Convey("Unspecified values should be hidden", func() {
+			for _, value := range append(orderStatus.Values, httpMethod.Values...) {
+				So(value.Name, ShouldNotContainSubstring, "UNSPECIFIED")
+			}
+		})
+		Convey("Lists of enums with null unspecified value should have nullable elements", func() {
+			So(types, ShouldContainSubstring, `graphql.NewList(graphql.NewNonNull(OrderStatus))`)
+			So(types, ShouldContainSubstring, `graphql.NewList(HTTPMethod)`)
+		})
+	})
+}
+
+func TestEnumsUnknownValues(t *testing.T) {
+	Convey("Test resolving of values, which are unknown to generated enums", t, func() {
+		cfg := `
+proto2gql:
+  files:
+    - proto_path: "./enums.proto"
+      output_path: "./out/enums"
+      output_package: "enums"
+      enums:
+        - "^OrderStatus$":
+            unknown_value: "fallback"
+        - "^HTTPMethod$":
+            unknown_value: "report"
+`
+		res, err := generateTestdata(testdataDir, cfg)
+		So(err, ShouldBeNil)
+		file := res.types["out/enums/enums.go"]
+		So(file, ShouldNotBeNil)
+		types := res.files["out/enums/enums.go"]
+
+		Convey("Fallback enums should have fallback value", func() {
+			So(findEnum(file, "OrderStatus").UnknownValue, ShouldEqual, "UNKNOWN")
+			So(findEnum(file, "HTTPMethod").UnknownValue, ShouldBeEmpty)
+			So(types, ShouldContainSubstring, "\"UNKNOWN\": &graphql.EnumValueConfig{\n\t\t\tValue:       enums.Unknown,")
+			So(strings.Count(types, "enums.Unknown"), ShouldEqual, 1)
+		})
+		Convey("Fallback enums values should be resolved with fallback", func() {
+			So(types, ShouldContainSubstring, `return enums.Value(OrderStatus, int(s.GetStatus())), nil`)
+			So(types, ShouldContainSubstring, `return enums.Values(OrderStatus, func(arg []enums_1.OrderStatus) []int {`)
+		})
+		Convey("Fallback value should be rejected in inputs", func() {
+			So(types, ShouldContainSubstring, `val, err := enums.Input(arg)`)
+		})
+		Convey("Report enums values should be reported", func() {
+			So(types, ShouldContainSubstring, `return enums.ReportValues(p, HTTPMethod, func(arg []enums_1.HTTPMethod) []int {`)
+		})
+		Convey("Fallback value name should not collide with enum values", func() {
+			_, err := generateTestdata(testdataDir, cfg, configSplice{
+				old: `unknown_value: "fallback"`,
+				new: "unknown_value: \"fallback\"\n            unknown_value_name: \"ORDER_STATUS_DONE\"",
+			})
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldContainSubstring, "ORDER_STATUS_DONE")
+		})
+		Convey("Fallback enums data loaders arguments should return input errors", func() {
+			res, err := generateTestdata(testdataDir, "data_loaders:\n  output_path: \"./out/loaders\"\n\n"+cfg+`
+    - proto_path: "./enums_loaders.proto"
+      output_path: "./out/enums_loaders"
+      output_package: "enums_loaders"
+      paths: ["./"]
+      services:
+        OrdersInfo:
+          methods:
+            List:
+              data_loaders:
+                OrdersByIDs:
+                  request_field: "ids"
+                  result_field: "orders"
+                  match_field: "id"
+                  type: "1-1"
+      messages:
+        - "^OrderInfo$":
+            data_loaders:
+              - field_name: "parent"
+                key_field_name: "parent_id"
+                data_loader_name: "OrdersByIDs"
+                args:
+                  - name: "status"
+                    request_field: "status"
+`)
+			So(err, ShouldBeNil)
+			types := res.files["out/enums_loaders/enums_loaders.go"]
+			So(types, ShouldContainSubstring, `if p.Args["status"] != nil {
+				v, err := func(arg interface{}) (enums_1.OrderStatus, error) {`)
+			So(types, ShouldContainSubstring, `args.Status = v`)
+		})
+	})
+}
+
+func TestInt64Format(t *testing.T) {
+	Convey("Test 64-bit integers serialization formats", t, func() {
+		cfg := `
+proto2gql:
+  int64_format: "string"
+  files:
+    - proto_path: "./scalars.proto"
+      output_path: "./out/scalars"
+      output_package: "scalars"
+      messages:
+        - "^Account$":
+            fields:
+              version: {int64_format: "number"}
+`
+		res, err := generateTestdata(testdataDir, cfg)
+		So(err, ShouldBeNil)
+		types := res.files["out/scalars/scalars.go"]
+		So(types, ShouldNotBeEmpty)
+
+		Convey("64-bit integers should be serialized as strings", func() {
+			So(types, ShouldContainSubstring, `AccountInput.AddFieldConfig("id", &graphql.InputObjectFieldConfig{Type: scalars.GraphQLInt64StringScalar`)
+			So(types, ShouldContainSubstring, `AccountInput.AddFieldConfig("balance", &graphql.InputObjectFieldConfig{Type: scalars.GraphQLUInt64StringScalar`)
+			So(types, ShouldContainSubstring, `graphql.NewList(graphql.NewNonNull(scalars.GraphQLInt64StringScalar))`)
+			So(types, ShouldContainSubstring, `"value": &graphql.InputObjectFieldConfig{Type: scalars.GraphQLInt64StringScalar}`)
+		})
+		Convey("Field format should override global one", func() {
+			So(types, ShouldContainSubstring, `AccountInput.AddFieldConfig("version", &graphql.InputObjectFieldConfig{Type: scalars.GraphQLUInt64Scalar`)
+			So(strings.Count(types, "scalars.GraphQLUInt64Scalar,"), ShouldEqual, 2) // input and output objects fields
+		})
+		Convey("32-bit integers shouldn't be affected", func() {
+			So(types, ShouldContainSubstring, `AccountInput.AddFieldConfig("age", &graphql.InputObjectFieldConfig{Type: scalars.GraphQLInt32Scalar`)
+		})
+		Convey("Unknown format should be rejected", func() {
+			_, err := generateTestdata(testdataDir, cfg, configSplice{old: `int64_format: "number"`, new: `int64_format: "hex"`})
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldContainSubstring, "unknown int64_format 'hex'")
+		})
+	})
+}
+
+func TestDataLoaderProviderAuth(t *testing.T) {
+	Convey("Test data loaders providers methods policies", t, func() {
+		cfg := `
+data_loaders:
+  output_path: "./out/loaders"
+
+proto2gql:
+  files:
+    - proto_path: "../tests/dataloader/apis/category.proto"
+      output_path: "./out/category"
+      output_package: "category"
+      services:
+        CategoryService:
+          methods:
+            List:
+              data_loaders:
+                CategoriesByIDs:
+                  request_field: "id"
+                  result_field: "categories"
+                  match_field: "id"
+                  type: "1-1"
+`
+		Convey("Data loader provider without policy should be generated", func() {
+			res, err := generateTestdata(testdataDir, cfg)
+			So(err, ShouldBeNil)
+			So(res.types["out/category/category.go"], ShouldNotBeNil)
+		})
+		Convey("Data loader provider with policy should be rejected, because loaders bypass interceptors", func() {
+			_, err := generateTestdata(testdataDir, cfg, configSplice{
+				old: "            List:\n",
+				new: "            List:\n              auth: {roles: [\"admin\"]}\n",
+			})
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldContainSubstring, "data loaders provider method can't have auth policy")
+		})
+	})
+}
+
+func TestAuthTemplatesOverrides(t *testing.T) {
+	Convey("Test templates overrides, which don't check authorization policies", t, func() {
+		dir, err := ioutil.TempDir("", "go2gql-templates")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(dir)
+		So(ioutil.WriteFile(filepath.Join(dir, "types_service.gohtml"), []byte("// {{.Service.Name}} methods\n"), 0666), ShouldBeNil)
+
+		cfg := `
+templates_dir: "` + dir + `"
+templates_version: ` + strconv.Itoa(generator.TemplatesContextVersion) + `
+proto2gql:
+  files:
+    - proto_path: "./enums.proto"
+      output_path: "./out/enums"
+      output_package: "enums"
+      services:
+        Orders:
+          methods:
+            CreateOrder: {}
+`
+		Convey("Override should be used, if policies aren't configured", func() {
+			res, err := generateTestdata(testdataDir, cfg)
+			So(err, ShouldBeNil)
+			So(res.files["out/enums/enums.go"], ShouldContainSubstring, "// Orders methods")
+		})
+		Convey("Override should be rejected, if policies are configured", func() {
+			_, err := generateTestdata(testdataDir, cfg, configSplice{old: "CreateOrder: {}", new: `CreateOrder: {auth: {roles: ["admin"]}}`})
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldContainSubstring, "template override "+filepath.Join(dir, "types_service.gohtml")+" never references `.Auth`")
+		})
+	})
+}
@@ -34,6 +34,16 @@ var scalarsResolvers = map[string]graphql.TypeResolver{
 	"bytes": graphql.GqlBytesTypeResolver,
 }
 
+// int64StringScalarsResolvers are resolvers of 64-bit integers scalars in string int64 format.
+var int64StringScalarsResolvers = map[string]graphql.TypeResolver{
+	"int64":    graphql.GqlInt64StringTypeResolver,
+	"sfixed64": graphql.GqlInt64StringTypeResolver,
+	"sint64":   graphql.GqlInt64StringTypeResolver,
+
+	"uint64":  graphql.GqlUInt64StringTypeResolver,
+	"fixed64": graphql.GqlUInt64StringTypeResolver,
+}
+
 type parsedFile struct {
 	File           *parser.File
 	Config         *ProtoFileConfig
@@ -49,6 +59,8 @@ type Proto2GraphQL struct {
 	parser           parser.Parser
 	ParsedFiles      []*parsedFile
 	OutputPath       string
+	FieldNaming      string // global fields naming strategy
+	Int64Format      string // global 64-bit integers format
 }
 
 func (g *Proto2GraphQL) parsedFile(file *parser.File) (*parsedFile, error) {
@@ -75,8 +87,10 @@ func (g *Proto2GraphQL) parsedFile(file *parser.File) (*parsedFile, error) {
 
 }
 func (g *Proto2GraphQL) prepareFile(file *parsedFile) (*graphql.TypesFile, error) {
-	enums := g.prepareFileEnums(file)
-
+	enums, err := g.prepareFileEnums(file)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to prepare file enums")
+	}
 	inputs, err := g.fileInputObjects(file)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to prepare file input objects")
@@ -106,6 +120,7 @@ func (g *Proto2GraphQL) prepareFile(file *parsedFile) (*graphql.TypesFile, error
 		return nil, errors.Wrap(err, "failed to prepare file services")
 	}
 	res := &graphql.TypesFile{
+		Source:                  file.File.FilePath,
 		PackageName:             file.OutputPkgName,
 		Package:                 file.OutputPkg,
 		Enums:                   enums,
@@ -173,3 +173,19 @@ func camelCase(s string) string {
 func camelCaseSlice(elem []string) string      { return camelCase(strings.Join(elem, "")) }
 func snakeCamelCaseSlice(elem []string) string { return camelCase(strings.Join(elem, "_")) }
 func dotedTypeName(elems []string) string      { return camelCase(strings.Join(elems, ".")) }
+
+// mergeTags returns tags of proto option and config without duplicates.
+func mergeTags(optionTags, cfgTags []string) []string {
+	var res []string
+	seen := make(map[string]bool)
+	for _, tags := range [][]string{optionTags, cfgTags} {
+		for _, tag := range tags {
+			if !seen[tag] {
+				seen[tag] = true
+				res = append(res, tag)
+			}
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,266 @@
+package proto2gql
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/EGT-Ukraine/go2gql/generator/plugins/graphql"
+	"github.com/EGT-Ukraine/go2gql/generator/plugins/proto2gql/parser"
+)
+
+const (
+	FieldMaskPkgPath     = "github.com/EGT-Ukraine/go2gql/api/fieldmask"
+	FieldMaskMessageName = "google.protobuf.FieldMask"
+)
+
+type maskField struct {
+	GraphQLName string
+	Path        string
+	Children    []maskField // nil means, that field is leaf
+	Requires    []string    // paths of fields, which are needed to resolve field. E.g. data loader keys
+}
+
+func (g *Proto2GraphQL) methodMaskField(method *parser.Method, fieldName string) (*parser.NormalField, error) {
+	for _, fld := range method.InputMessage.NormalFields {
+		if fld.Name != fieldName {
+			continue
+		}
+		msg, ok := fld.Type.(*parser.Message)
+		if !ok || fld.Repeated || msg.GetFullName() != FieldMaskMessageName {
+			return nil, errors.Errorf("field '%s' of message '%s' must be of type %s", fieldName, method.InputMessage.Name, FieldMaskMessageName)
+		}
+
+		return fld, nil
+	}
+
+	return nil, errors.Errorf("field '%s' not found in message '%s'", fieldName, method.InputMessage.Name)
+}
+
+func (g *Proto2GraphQL) outputMessageMaskFields(msg *parser.Message, stack map[*parser.Message]bool) ([]maskField, error) {
+	if stack[msg] {
+		// recursive message, so we can only request it as a whole.
+		return nil, nil
+	}
+	stack[msg] = true
+	defer delete(stack, msg)
+
+	msgFile, err := g.parsedFile(msg.File())
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to resolve message %s parsed file", msg.Name)
+	}
+	msgCfg, err := msgFile.Config.MessageConfig(msg.Name)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to resolve message %s config", msg.Name)
+	}
+
+	var res []maskField
+	for _, fld := range msg.GetFields() {
+		if fld.GetName() == msgCfg.ErrorField || msgCfg.fieldExcluded(fld.GetName()) {
+			continue
+		}
+		field := maskField{
+			GraphQLName: g.fieldName(msg, msgCfg, fld),
+			Path:        fld.GetName(),
+		}
+		// repeated fields are allowed only at the last position of field mask path.
+		if fldMsg, ok := fld.GetType().(*parser.Message); ok && !fld.IsRepeated() {
+			fldMsgFile, err := g.parsedFile(fldMsg.File())
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to resolve message %s parsed file", fldMsg.Name)
+			}
+			fldMsgCfg, err := fldMsgFile.Config.MessageConfig(fldMsg.Name)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to resolve message %s config", fldMsg.Name)
+			}
+			if fldMsgCfg.UnwrapField && len(fldMsg.NormalFields) == 1 {
+				unwrapped := fldMsg.NormalFields[0]
+				field.Path += "." + unwrapped.Name
+				fldMsg, ok = unwrapped.Type.(*parser.Message)
+				ok = ok && !unwrapped.IsRepeated()
+			}
+			if ok {
+				field.Children, err = g.outputMessageMaskFields(fldMsg, stack)
+				if err != nil {
+					return nil, errors.Wrapf(err, "failed to resolve message %s field %s mask fields", msg.Name, fld.GetName())
+				}
+			}
+		}
+		res = append(res, field)
+	}
+	for _, cfg := range msgCfg.DataLoaders {
+		res = append(res, maskField{
+			GraphQLName: cfg.FieldName,
+			Requires:    cfg.KeyFields(),
+		})
+	}
+
+	return res, nil
+}
+
+func (g *Proto2GraphQL) methodOutputMaskFields(method *parser.Method) ([]maskField, error) {
+	outputMsgCfg, err := g.fileConfig(method.OutputMessage.File()).MessageConfig(method.OutputMessage.Name)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to resolve message %s config", method.OutputMessage.Name)
+	}
+	if !outputMsgCfg.UnwrapField {
+		return g.outputMessageMaskFields(method.OutputMessage, map[*parser.Message]bool{})
+	}
+	if len(method.OutputMessage.NormalFields) != 1 {
+		return nil, errors.Errorf("can't unwrap `%s` message. Output message must have 1 field.", method.OutputMessage.Name)
+	}
+	unwrapped := method.OutputMessage.NormalFields[0]
+	unwrappedMsg, ok := unwrapped.Type.(*parser.Message)
+	if !ok {
+		return nil, errors.Errorf("can't build read mask for unwrapped field `%s`, which is not a message", unwrapped.Name)
+	}
+	fields, err := g.outputMessageMaskFields(unwrappedMsg, map[*parser.Message]bool{})
+	if err != nil {
+		return nil, err
+	}
+	for i := range fields {
+		if fields[i].Path != "" {
+			fields[i].Path = unwrapped.Name + "." + fields[i].Path
+		}
+		requires := make([]string, len(fields[i].Requires))
+		for j, path := range fields[i].Requires {
+			requires[j] = unwrapped.Name + "." + path
+		}
+		fields[i].Requires = requires
+	}
+
+	return fields, nil
+}
+
+func renderMaskFields(fields []maskField, ctx graphql.BodyContext) string {
+	pkg := ctx.Importer.New(FieldMaskPkgPath)
+	res := "map[string]" + pkg + ".Field{\n"
+	for _, fld := range fields {
+		var props []string
+		if fld.Path != "" {
+			props = append(props, "Path: "+strconv.Quote(fld.Path))
+		}
+		if len(fld.Requires) > 0 {
+			var requires []string
+			for _, path := range fld.Requires {
+				requires = append(requires, strconv.Quote(path))
+			}
+			props = append(props, "Requires: []string{"+strings.Join(requires, ", ")+"}")
+		}
+		if fld.Children != nil {
+			props = append(props, "Children: func() map[string]"+pkg+".Field {\nreturn "+renderMaskFields(fld.Children, ctx)+"\n}")
+		}
+		res += strconv.Quote(fld.GraphQLName) + ": {" + strings.Join(props, ", ") + "},\n"
+	}
+
+	return res + "}"
+}
+
+func (g *Proto2GraphQL) inputMessageMaskFields(msg *parser.Message, stack map[*parser.Message]bool) ([]maskField, error) {
+	if stack[msg] {
+		return nil, nil
+	}
+	stack[msg] = true
+	defer delete(stack, msg)
+
+	msgFile, err := g.parsedFile(msg.File())
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to resolve message %s parsed file", msg.Name)
+	}
+	msgCfg, err := msgFile.Config.MessageConfig(msg.Name)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to resolve message %s config", msg.Name)
+	}
+
+	var res []maskField
+	for _, fld := range msg.GetFields() {
+		if normalFld, ok := fld.(*parser.NormalField); ok && msgCfg.Fields[normalFld.Name].ContextKey != "" || msgCfg.fieldExcluded(fld.GetName()) {
+			continue
+		}
+		field := maskField{
+			GraphQLName: g.fieldName(msg, msgCfg, fld),
+			Path:        fld.GetName(),
+		}
+		fldMsg, ok := fld.GetType().(*parser.Message)
+		if ok && !fld.IsRepeated() {
+			fldMsgFile, err := g.parsedFile(fldMsg.File())
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to resolve message %s parsed file", fldMsg.Name)
+			}
+			fldMsgCfg, err := fldMsgFile.Config.MessageConfig(fldMsg.Name)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to resolve message %s config", fldMsg.Name)
+			}
+			if !fldMsgCfg.UnwrapField {
+				field.Children, err = g.inputMessageMaskFields(fldMsg, stack)
+				if err != nil {
+					return nil, errors.Wrapf(err, "failed to resolve message %s field %s mask fields", msg.Name, fld.GetName())
+				}
+			}
+		}
+		res = append(res, field)
+	}
+
+	return res, nil
+}
+
+func (g *Proto2GraphQL) maskRequestResolver(method *parser.Method, maskFieldName string, resolver graphql.ValueResolver, paths func(arg string, ctx graphql.BodyContext) string) (graphql.ValueResolver, error) {
+	maskFld, err := g.methodMaskField(method, maskFieldName)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to resolve mask field")
+	}
+	maskGoType, err := g.goTypeByParserType(maskFld.Type)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to resolve mask go type")
+	}
+	requestGoType, err := g.goTypeByParserType(method.InputMessage)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to resolve request go type")
+	}
+
+	return func(arg string, ctx graphql.BodyContext) string {
+		return `func() (` + requestGoType.String(ctx.Importer) + `, error) {
+			req, err := ` + resolver(arg, ctx) + `
+			if err != nil {
+				return nil, err
+			}
+			if req == nil {
+				req = new(` + requestGoType.ElemType.String(ctx.Importer) + `)
+			}
+			req.` + camelCase(maskFld.Name) + ` = &` + maskGoType.ElemType.String(ctx.Importer) + `{
+				Paths: ` + paths(arg, ctx) + `,
+			}
+
+			return req, nil
+		}()`
+	}, nil
+}
+
+func (g *Proto2GraphQL) readMaskRequestResolver(method *parser.Method, cfg MethodConfig, resolver graphql.ValueResolver) (graphql.ValueResolver, error) {
+	fields, err := g.methodOutputMaskFields(method)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to resolve output message mask fields")
+	}
+
+	return g.maskRequestResolver(method, cfg.ReadMaskField, resolver, func(arg string, ctx graphql.BodyContext) string {
+		return ctx.Importer.New(FieldMaskPkgPath) + ".Paths(p.Info, " + renderMaskFields(fields, ctx) + ")"
+	})
+}
+
+func (g *Proto2GraphQL) updateMaskRequestResolver(method *parser.Method, cfg MethodConfig, resolver graphql.ValueResolver) (graphql.ValueResolver, error) {
+	fields, err := g.inputMessageMaskFields(method.InputMessage, map[*parser.Message]bool{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to resolve input message mask fields")
+	}
+	var argsFields []maskField
+	for _, fld := range fields {
+		if fld.Path != cfg.ReadMaskField && fld.Path != cfg.UpdateMaskField {
+			argsFields = append(argsFields, fld)
+		}
+	}
+
+	return g.maskRequestResolver(method, cfg.UpdateMaskField, resolver, func(arg string, ctx graphql.BodyContext) string {
+		return ctx.Importer.New(FieldMaskPkgPath) + ".ArgsPaths(" + arg + ", " + renderMaskFields(argsFields, ctx) + ")"
+	})
+}
@@ -25,8 +25,14 @@ func (g *Proto2GraphQL) inputMessageTypeResolver(msgFile *parsedFile, message *p
 	}
 }
 
-func (g *Proto2GraphQL) inputMessageFieldTypeResolver(file *parsedFile, field *parser.NormalField) (graphql.TypeResolver, error) {
-	resolver, err := g.TypeInputGraphQLTypeResolver(file, field.Type)
+func (g *Proto2GraphQL) inputMessageFieldTypeResolver(file *parsedFile, msg *parser.Message, field *parser.NormalField) (graphql.TypeResolver, error) {
+	var resolver graphql.TypeResolver
+	var err error
+	if scalar, ok := field.Type.(*parser.Scalar); ok {
+		resolver, err = g.fieldScalarTypeResolver(file, msg, field, scalar)
+	} else {
+		resolver, err = g.TypeInputGraphQLTypeResolver(file, field.Type)
+	}
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get input type resolver")
 	}
@@ -65,11 +71,25 @@ func (g *Proto2GraphQL) fileInputObjects(file *parsedFile) ([]graphql.InputObjec
 			continue
 		}
 
+		var names []string
+		for _, field := range fields {
+			names = append(names, field.Name)
+		}
+		if err := checkNamesCollisions("object "+g.inputMessageGraphQLName(file, msg), "fields", names); err != nil {
+			return nil, err
+		}
+
+		msgCfg, err := file.Config.MessageConfig(msg.Name)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to resolve message %s config", msg.Name)
+		}
+
 		// TODO: oneof fields
 		res = append(res, graphql.InputObject{
 			VariableName: g.inputMessageVariable(file, msg),
 			GraphQLName:  g.inputMessageGraphQLName(file, msg),
 			Fields:       fields,
+			Visibility:   mergeTags(msg.Visibility, msgCfg.Visibility),
 		})
 	}
 
@@ -89,7 +109,7 @@ func (g *Proto2GraphQL) getMessageFields(file *parsedFile, msg *parser.Message)
 	var fields []graphql.ObjectField
 	for _, field := range msg.NormalFields {
 		fldCfg := msgCfg.Fields[field.Name]
-		if fldCfg.ContextKey != "" {
+		if fldCfg.ContextKey != "" || fldCfg.Exclude {
 			continue
 		}
 
@@ -112,6 +132,9 @@ func (g *Proto2GraphQL) getMessageFields(file *parsedFile, msg *parser.Message)
 				if err != nil {
 					return nil, errors.Wrapf(err, "failed to resolve unwrapped message %s field", fieldTypeMessage.Name)
 				}
+				unwrappedField.Name = g.fieldName(msg, msgCfg, field)
+				unwrappedField.QuotedComment = msgCfg.fieldQuotedComment(field.Name, unwrappedField.QuotedComment)
+				unwrappedField.Visibility = mergeTags(field.Visibility, fldCfg.Visibility)
 
 				fields = append(fields, *unwrappedField)
 
@@ -119,42 +142,51 @@ func (g *Proto2GraphQL) getMessageFields(file *parsedFile, msg *parser.Message)
 			}
 		}
 
-		typ, err := g.inputMessageFieldTypeResolver(fieldTypeFile, field)
+		typ, err := g.inputMessageFieldTypeResolver(fieldTypeFile, msg, field)
 		if err != nil {
 			return nil, errors.Wrapf(err, "failed to resolve `%s.%s` field type", msg.Name, field.Name)
 		}
 
 		fields = append(fields, graphql.ObjectField{
-			Name:          field.Name,
+			Name:          g.fieldName(msg, msgCfg, field),
 			Type:          typ,
-			QuotedComment: field.QuotedComment,
+			QuotedComment: msgCfg.fieldQuotedComment(field.Name, field.QuotedComment),
+			Visibility:    mergeTags(field.Visibility, fldCfg.Visibility),
 		})
 	}
 	for _, field := range msg.MapFields {
+		if msgCfg.fieldExcluded(field.Name) {
+			continue
+		}
 		typ, err := g.inputObjectMapFieldTypeResolver(file, field.Map)
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to resolve field type")
 		}
 		fields = append(fields, graphql.ObjectField{
-			Name:          field.Name,
+			Name:          g.fieldName(msg, msgCfg, field),
 			Type:          typ,
-			QuotedComment: field.QuotedComment,
+			QuotedComment: msgCfg.fieldQuotedComment(field.Name, field.QuotedComment),
+			Visibility:    mergeTags(field.Visibility, msgCfg.Fields[field.Name].Visibility),
 		})
 	}
 	for _, oneOf := range msg.OneOffs {
 		for _, fld := range oneOf.Fields {
+			if msgCfg.fieldExcluded(fld.Name) {
+				continue
+			}
 			fieldTypeFile, err := g.parsedFile(fld.Type.File())
 			if err != nil {
 				return nil, errors.Wrap(err, "failed to resolve value type file")
 			}
-			typ, err := g.inputMessageFieldTypeResolver(fieldTypeFile, fld)
+			typ, err := g.inputMessageFieldTypeResolver(fieldTypeFile, msg, fld)
 			if err != nil {
 				return nil, errors.Wrap(err, "failed to resolve field type")
 			}
 			fields = append(fields, graphql.ObjectField{
-				Name:          fld.Name,
+				Name:          g.fieldName(msg, msgCfg, fld),
 				Type:          typ,
-				QuotedComment: fld.QuotedComment,
+				QuotedComment: msgCfg.fieldQuotedComment(fld.Name, fld.QuotedComment),
+				Visibility:    mergeTags(fld.Visibility, msgCfg.Fields[fld.Name].Visibility),
 			})
 		}
 	}
@@ -183,6 +215,10 @@ func (g *Proto2GraphQL) getUnwrappedField(field *parser.NormalField) (*graphql.O
 		return nil, err
 	}
 
+	if len(messageFields) != 1 {
+		return nil, errors.Errorf("can't unwrap message %s. Its field is excluded or resolved from context", unwrappedMessage.Name)
+	}
+
 	unwrappedField := &messageFields[0]
 	unwrappedField.Name = field.Name
 
@@ -2,6 +2,7 @@ package proto2gql
 
 import (
 	"reflect"
+	"strings"
 
 	"github.com/pkg/errors"
 
@@ -38,7 +39,7 @@ func (g *Proto2GraphQL) outputMessageFields(msgCfg MessageConfig, msg *parser.Me
 	}
 	var res []graphql.ObjectField
 	for _, field := range msg.NormalFields {
-		if msgCfg.ErrorField == field.Name {
+		if msgCfg.ErrorField == field.Name || msgCfg.fieldExcluded(field.Name) {
 			continue
 		}
 		fieldGoType, err := g.goTypeByParserType(field.Type)
@@ -61,6 +62,9 @@ func (g *Proto2GraphQL) outputMessageFields(msgCfg MessageConfig, msg *parser.Me
 				if err != nil {
 					return nil, errors.Wrap(err, "failed to resolve output message unwrapped field")
 				}
+				object.Name = g.fieldName(msg, msgCfg, field)
+				object.QuotedComment = msgCfg.fieldQuotedComment(field.Name, object.QuotedComment)
+				object.Visibility = mergeTags(field.Visibility, msgCfg.Fields[field.Name].Visibility)
 				res = append(res, *object)
 				continue
 			}
@@ -70,13 +74,16 @@ func (g *Proto2GraphQL) outputMessageFields(msgCfg MessageConfig, msg *parser.Me
 			return nil, errors.Wrap(err, "failed to resolve file type file")
 		}
 
-		typeResolver, err := g.TypeOutputGraphQLTypeResolver(fieldTypeFile, field.Type)
+		typeResolver, err := g.outputFieldTypeResolver(fieldTypeFile, msg, field)
 		if err != nil {
 			return nil, errors.Wrapf(err, "failed to prepare message %s field %s output type resolver", msg.Name, field.Name)
 		}
 
 		if field.Repeated {
-			typeResolver = graphql.GqlListTypeResolver(graphql.GqlNonNullTypeResolver(typeResolver))
+			typeResolver, err = g.repeatedOutputTypeResolver(field.Type, typeResolver)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to prepare message %s field %s output type resolver", msg.Name, field.Name)
+			}
 		}
 		valueResolver, err := g.FieldOutputValueResolver(msg, field.Name)
 		if err != nil {
@@ -84,31 +91,33 @@ func (g *Proto2GraphQL) outputMessageFields(msgCfg MessageConfig, msg *parser.Me
 		}
 
 		res = append(res, graphql.ObjectField{
-			Name:          field.Name,
-			QuotedComment: field.QuotedComment,
+			Name:          g.fieldName(msg, msgCfg, field),
+			QuotedComment: msgCfg.fieldQuotedComment(field.Name, field.QuotedComment),
 			Type:          typeResolver,
 			GoType:        fieldGoType,
 			Value:         valueResolver,
+			Visibility:    mergeTags(field.Visibility, msgCfg.Fields[field.Name].Visibility),
 		})
 	}
 	for _, of := range msg.OneOffs {
 		for _, field := range of.Fields {
-			if msgCfg.ErrorField == field.Name {
+			if msgCfg.ErrorField == field.Name || msgCfg.fieldExcluded(field.Name) {
 				continue
 			}
 			fieldTypeFile, err := g.parsedFile(field.Type.File())
 			if err != nil {
 				return nil, errors.Wrap(err, "failed to resolve file type file")
 			}
-			typeResolver, err := g.TypeOutputGraphQLTypeResolver(fieldTypeFile, field.Type)
+			typeResolver, err := g.outputFieldTypeResolver(fieldTypeFile, msg, field)
 			if err != nil {
 				return nil, errors.Wrapf(err, "failed to prepare message %s field %s output type resolver", msg.Name, field.Name)
 			}
 			res = append(res, graphql.ObjectField{
-				Name:          field.Name,
-				QuotedComment: field.QuotedComment,
+				Name:          g.fieldName(msg, msgCfg, field),
+				QuotedComment: msgCfg.fieldQuotedComment(field.Name, field.QuotedComment),
 				Type:          typeResolver,
 				Value:         graphql.IdentAccessValueResolver("Get" + camelCase(field.Name) + "()"),
+				Visibility:    mergeTags(field.Visibility, msgCfg.Fields[field.Name].Visibility),
 			})
 		}
 	}
@@ -119,7 +128,7 @@ func (g *Proto2GraphQL) outputMessageFields(msgCfg MessageConfig, msg *parser.Me
 func (g *Proto2GraphQL) outputMessageMapFields(msgCfg MessageConfig, file *parsedFile, msg *parser.Message) ([]graphql.ObjectField, error) {
 	var res []graphql.ObjectField
 	for _, field := range msg.MapFields {
-		if msgCfg.ErrorField == field.Name {
+		if msgCfg.ErrorField == field.Name || msgCfg.fieldExcluded(field.Name) {
 			continue
 		}
 		typeResolver, err := g.TypeOutputGraphQLTypeResolver(file, field.Map)
@@ -127,9 +136,11 @@ func (g *Proto2GraphQL) outputMessageMapFields(msgCfg MessageConfig, file *parse
 			return nil, errors.Wrapf(err, "failed to prepare message %s field %s output type resolver", msg.Name, field.Name)
 		}
 		res = append(res, graphql.ObjectField{
-			Name:  field.Name,
-			Type:  typeResolver,
-			Value: graphql.IdentAccessValueResolver(camelCase(field.Name)),
+			Name:          g.fieldName(msg, msgCfg, field),
+			QuotedComment: msgCfg.fieldQuotedComment(field.Name, field.QuotedComment),
+			Type:          typeResolver,
+			Value:         graphql.IdentAccessValueResolver(camelCase(field.Name)),
+			Visibility:    mergeTags(field.Visibility, msgCfg.Fields[field.Name].Visibility),
 		})
 	}
 
@@ -140,22 +151,43 @@ func (g *Proto2GraphQL) dataLoaderFields(configs []dataloader.FieldConfig, msg *
 	var fields []*graphql.DataLoaderField
 
 	for _, cfg := range configs {
-		msgKeyField, ok := msg.GetFieldByName(cfg.KeyFieldName)
-		if !ok {
-			return nil, errors.Errorf("can't find key field %s for dataloader", cfg.KeyFieldName)
+		if cfg.KeyFieldName != "" && len(cfg.KeyFieldNames) > 0 {
+			return nil, errors.Errorf("key_field_name and key_field_names can't be used together in %s data loader field", cfg.FieldName)
 		}
 
-		normalKeyField, ok := msgKeyField.(*parser.NormalField)
-		if !ok {
-			return nil, errors.Errorf("only normal fields(not maps) can be keys for dataloaders")
+		var keyFields []graphql.DataLoaderKeyField
+		for _, keyFieldPath := range cfg.KeyFields() {
+			msgKeyField, err := messageFieldByPath(msg, keyFieldPath)
+			if err != nil {
+				return nil, errors.Wrapf(err, "can't find key field %s for dataloader", keyFieldPath)
+			}
+
+			normalKeyField, ok := msgKeyField.(*parser.NormalField)
+			if !ok {
+				return nil, errors.Errorf("only normal fields(not maps) can be keys for dataloaders")
+			}
+
+			keyFieldGoType, err := g.goTypeByParserType(normalKeyField.Type)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to resolve key field %s go type", keyFieldPath)
+			}
+
+			keyFields = append(keyFields, graphql.DataLoaderKeyField{
+				Path:     keyFieldPath,
+				GoType:   keyFieldGoType,
+				Repeated: normalKeyField.Repeated,
+				Value:    graphql.IdentAccessValueResolver(buildFieldGetterByFieldPath(keyFieldPath)),
+			})
 		}
 
 		field := &graphql.DataLoaderField{
 			Name:                         cfg.FieldName,
-			NormalizedParentKeyFieldName: camelCase(cfg.KeyFieldName),
-			ParentKeyFieldName:           cfg.KeyFieldName,
-			KeyFieldSlice:                normalKeyField.Repeated,
+			NormalizedParentKeyFieldName: buildFieldGetterByFieldPath(keyFields[0].Path),
+			ParentKeyFieldName:           strings.Join(cfg.KeyFields(), ","),
+			KeyFieldSlice:                len(keyFields) == 1 && keyFields[0].Repeated,
 			DataLoaderName:               cfg.DataLoader,
+			KeyFields:                    keyFields,
+			Args:                         cfg.FieldArgs(),
 		}
 
 		fields = append(fields, field)
@@ -190,6 +222,17 @@ func (g *Proto2GraphQL) fileOutputMessages(file *parsedFile) ([]graphql.OutputOb
 			continue
 		}
 
+		var names []string
+		for _, field := range append(fields, mapFields...) {
+			names = append(names, field.Name)
+		}
+		for _, field := range dataLoaderFields {
+			names = append(names, field.Name)
+		}
+		if err := checkNamesCollisions("object "+g.outputMessageGraphQLName(file, msg), "fields", names); err != nil {
+			return nil, err
+		}
+
 		res = append(res, graphql.OutputObject{
 			VariableName: g.outputMessageVariable(file, msg),
 			GraphQLName:  g.outputMessageGraphQLName(file, msg),
@@ -201,6 +244,8 @@ func (g *Proto2GraphQL) fileOutputMessages(file *parsedFile) ([]graphql.OutputOb
 				Pkg:  file.GRPCSourcesPkg,
 			},
 			DataLoaderFields: dataLoaderFields,
+			Node:             cfg.Node,
+			Visibility:       mergeTags(msg.Visibility, cfg.Visibility),
 		})
 	}
 
@@ -238,3 +283,28 @@ func (g *Proto2GraphQL) outputMessageUnwrappedField(msg, fieldMessage *parser.Me
 		Value:         valueResolver,
 	}, nil
 }
+
+// outputFieldTypeResolver returns output type resolver of message normal field.
+func (g *Proto2GraphQL) outputFieldTypeResolver(fieldTypeFile *parsedFile, msg *parser.Message, field *parser.NormalField) (graphql.TypeResolver, error) {
+	if scalar, ok := field.Type.(*parser.Scalar); ok {
+		return g.fieldScalarTypeResolver(fieldTypeFile, msg, field, scalar)
+	}
+
+	return g.TypeOutputGraphQLTypeResolver(fieldTypeFile, field.Type)
+}
+
+// repeatedOutputTypeResolver returns list type resolver of repeated field. Lists of enums with null unspecified value
+// have nullable elements.
+func (g *Proto2GraphQL) repeatedOutputTypeResolver(typ parser.Type, elemResolver graphql.TypeResolver) (graphql.TypeResolver, error) {
+	if enum, ok := typ.(*parser.Enum); ok {
+		unspecifiedValue, err := g.enumUnspecifiedValue(enum)
+		if err != nil {
+			return nil, err
+		}
+		if unspecifiedValue == UnspecifiedEnumValueNull {
+			return graphql.GqlListTypeResolver(elemResolver), nil
+		}
+	}
+
+	return graphql.GqlListTypeResolver(graphql.GqlNonNullTypeResolver(elemResolver)), nil
+}
@@ -95,6 +95,9 @@ func (g *Proto2GraphQL) fileInputMessagesResolvers(file *parsedFile) ([]graphql.
 		for _, oneOf := range msg.OneOffs {
 			var fields []graphql.InputObjectResolverOneOfField
 			for _, fld := range oneOf.Fields {
+				if msgCfg.fieldExcluded(fld.Name) {
+					continue
+				}
 				fldTypeFile, err := g.parsedFile(fld.Type.File())
 				if err != nil {
 					return nil, errors.Wrapf(err, "failed to resolve message '%s' field '%s' type parsed file", dotedTypeName(msg.TypeName), fld.Name)
@@ -104,7 +107,7 @@ func (g *Proto2GraphQL) fileInputMessagesResolvers(file *parsedFile) ([]graphql.
 					return nil, errors.Wrap(err, "failed to get type value resolver")
 				}
 				fields = append(fields, graphql.InputObjectResolverOneOfField{
-					GraphQLInputFieldName: fld.Name,
+					GraphQLInputFieldName: g.fieldName(msg, msgCfg, fld),
 					ValueResolver:         resolver,
 					ResolverWithError:     withErr,
 					AssigningWrapper:      g.oneOfValueAssigningWrapper(file, msg, fld),
@@ -122,6 +125,9 @@ func (g *Proto2GraphQL) fileInputMessagesResolvers(file *parsedFile) ([]graphql.
 				return nil, errors.Wrapf(err, "failed to resolve message '%s' field '%s' type parsed file", dotedTypeName(msg.TypeName), fld.Name)
 			}
 			fldCfg := msgCfg.Fields[fld.Name]
+			if fldCfg.Exclude && fldCfg.ContextKey == "" {
+				continue
+			}
 			resolver, withErr, fromArgs, err := g.TypeValueResolver(fldTypeFile, fld.Type, fldCfg.ContextKey, fld.Optional || fld.Required)
 			if err != nil {
 				return nil, errors.Wrap(err, "failed to get type value resolver")
@@ -138,7 +144,7 @@ func (g *Proto2GraphQL) fileInputMessagesResolvers(file *parsedFile) ([]graphql.
 				}
 			}
 			fields = append(fields, graphql.InputObjectResolverField{
-				GraphQLInputFieldName: fld.Name,
+				GraphQLInputFieldName: g.fieldName(msg, msgCfg, fld),
 				OutputFieldName:       camelCase(fld.Name),
 				ValueResolver:         resolver,
 				ResolverWithError:     withErr,
@@ -152,12 +158,15 @@ func (g *Proto2GraphQL) fileInputMessagesResolvers(file *parsedFile) ([]graphql.
 				return nil, errors.Wrapf(err, "failed to resolve message '%s' parsed file", dotedTypeName(msg.TypeName))
 			}
 			fldCfg := msgCfg.Fields[fld.Name]
+			if fldCfg.Exclude && fldCfg.ContextKey == "" {
+				continue
+			}
 			valueResolver, withErr, fromArgs, err := g.TypeValueResolver(valueTypeParsedFile, fld.Map, fldCfg.ContextKey, false)
 			if err != nil {
 				return nil, errors.Wrapf(err, "failed to get message '%s' map field '%s' value resolver", msg.Name, fld.Name)
 			}
 			fields = append(fields, graphql.InputObjectResolverField{
-				GraphQLInputFieldName: fld.Name,
+				GraphQLInputFieldName: g.fieldName(msg, msgCfg, fld),
 				OutputFieldName:       camelCase(fld.Name),
 				ValueResolver:         valueResolver,
 				ResolverWithError:     withErr,
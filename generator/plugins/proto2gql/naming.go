@@ -0,0 +1,111 @@
+package proto2gql
+
+import (
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+
+	"github.com/EGT-Ukraine/go2gql/generator/plugins/graphql"
+	"github.com/EGT-Ukraine/go2gql/generator/plugins/proto2gql/parser"
+)
+
+// Fields naming strategies.
+const (
+	FieldNamingOriginal   = "original"   // proto field names as is
+	FieldNamingLowerCamel = "lowerCamel" // lowerCamelCase proto field names
+	FieldNamingJSONName   = "json_name"  // json_name option values or protoc default JSON names
+)
+
+func validateFieldNaming(naming string) error {
+	switch naming {
+	case "", FieldNamingOriginal, FieldNamingLowerCamel, FieldNamingJSONName:
+		return nil
+	}
+
+	return errors.Errorf("unknown field_naming '%s'. Allowed values: %s, %s, %s", naming, FieldNamingOriginal, FieldNamingLowerCamel, FieldNamingJSONName)
+}
+
+// fieldNaming returns fields naming strategy of proto file.
+func (g *Proto2GraphQL) fieldNaming(file *parser.File) string {
+	if cfg := g.fileConfig(file); cfg != nil && cfg.FieldNaming != "" {
+		return cfg.FieldNaming
+	}
+
+	return g.FieldNaming
+}
+
+// fieldName returns GraphQL name of message field. Configured field name has priority over naming strategy.
+func (g *Proto2GraphQL) fieldName(msg *parser.Message, msgCfg MessageConfig, field parser.Field) string {
+	if name := msgCfg.Fields[field.GetName()].Name; name != "" {
+		return name
+	}
+	switch g.fieldNaming(msg.File()) {
+	case FieldNamingLowerCamel:
+		return lowerCamelCase(field.GetName())
+	case FieldNamingJSONName:
+		return field.GetJSONName()
+	}
+
+	return field.GetName()
+}
+
+// methodFieldName returns GraphQL name of service method field.
+func (g *Proto2GraphQL) methodFieldName(file *parser.File, method *parser.Method) string {
+	switch g.fieldNaming(file) {
+	case FieldNamingLowerCamel, FieldNamingJSONName:
+		return lowerCamelCase(method.Name)
+	}
+
+	return method.Name
+}
+
+func lowerCamelCase(s string) string {
+	if s == "" {
+		return ""
+	}
+	s = camelCase(s)
+	r, size := utf8.DecodeRuneInString(s)
+
+	return string(unicode.ToLower(r)) + s[size:]
+}
+
+// checkNamesCollisions returns error, if several GraphQL elements (fields, values) of owner have the same name.
+func checkNamesCollisions(owner, elements string, names []string) error {
+	seen := make(map[string]bool, len(names))
+	for _, name := range names {
+		if seen[name] {
+			return errors.Errorf("%s has several %s with GraphQL name '%s'", owner, elements, name)
+		}
+		seen[name] = true
+	}
+
+	return nil
+}
+
+func checkMethodsNamesCollisions(owner string, methods []graphql.Method) error {
+	names := make([]string, len(methods))
+	for i, method := range methods {
+		names[i] = method.Name
+	}
+
+	return checkNamesCollisions(owner, "fields", names)
+}
+
+// upperSnakeCase converts CamelCase name to UPPER_SNAKE_CASE. E.g. HTTPStatus to HTTP_STATUS.
+func upperSnakeCase(s string) string {
+	runes := []rune(s)
+	var res []rune
+	for i, r := range runes {
+		if i > 0 && unicode.IsUpper(r) {
+			prev := runes[i-1]
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || unicode.IsUpper(prev) && nextLower {
+				res = append(res, '_')
+			}
+		}
+		res = append(res, unicode.ToUpper(r))
+	}
+
+	return string(res)
+}
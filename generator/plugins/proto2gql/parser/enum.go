@@ -13,12 +13,14 @@ type Enum struct {
 	file          *File
 	TypeName      TypeName
 	Descriptor    *proto.Enum
+	Visibility    []string // tags of visibility option
 }
 
 type EnumValue struct {
 	Name          string
 	Value         int
 	QuotedComment string
+	Visibility    []string // tags of visibility option
 }
 
 func newEnum(file *File, enum *proto.Enum, typeName []string) *Enum {
@@ -28,6 +30,7 @@ func newEnum(file *File, enum *proto.Enum, typeName []string) *Enum {
 		Descriptor:    enum,
 		TypeName:      typeName,
 		file:          file,
+		Visibility:    visibilityTags(EnumVisibilityOption, elementsOptions(enum.Elements)),
 	}
 	for _, v := range enum.Elements {
 		value, ok := v.(*proto.EnumField)
@@ -38,6 +41,7 @@ func newEnum(file *File, enum *proto.Enum, typeName []string) *Enum {
 			Name:          value.Name,
 			Value:         value.Integer,
 			QuotedComment: quoteComment(value.Comment, value.InlineComment),
+			Visibility:    visibilityTags(EnumValueVisibilityOption, elementsOptions(value.Elements)),
 		})
 	}
 
@@ -131,6 +131,7 @@ func (f *File) parseServices() error {
 				InputMessage:  reqTyp.(*Message),
 				OutputMessage: retTyp.(*Message),
 				Service:       srv,
+				Visibility:    visibilityTags(MethodVisibilityOption, elementsOptions(method.Elements)),
 			}
 			srv.Methods[mtd.Name] = mtd
 		}
@@ -151,12 +152,14 @@ func (f *File) parseMessagesFields() error {
 				}
 				fl := &NormalField{
 					Name:          fld.Name,
+					JSONName:      jsonName(fld.Name, fld.Options),
 					QuotedComment: quoteComment(fld.Comment, fld.InlineComment),
 					Repeated:      fld.Repeated,
 					Optional:      fld.Optional,
 					Required:      fld.Required,
 					descriptor:    fld.Field,
 					Type:          typ,
+					Visibility:    visibilityTags(FieldVisibilityOption, fld.Options),
 				}
 				msg.NormalFields = append(msg.NormalFields, fl)
 			case *proto.MapField:
@@ -177,9 +180,11 @@ func (f *File) parseMessagesFields() error {
 				}
 				mf := &MapField{
 					Name:          fld.Name,
+					JSONName:      jsonName(fld.Name, fld.Options),
 					QuotedComment: quoteComment(fld.Comment, fld.InlineComment),
 					descriptor:    fld,
 					Map:           mp,
+					Visibility:    visibilityTags(FieldVisibilityOption, fld.Options),
 				}
 				msg.MapFields = append(msg.MapFields, mf)
 			case *proto.Oneof:
@@ -197,11 +202,13 @@ func (f *File) parseMessagesFields() error {
 					}
 					of.Fields = append(of.Fields, &NormalField{
 						Name:          fld.Name,
+						JSONName:      jsonName(fld.Name, fld.Options),
 						QuotedComment: quoteComment(fld.Comment, fld.InlineComment),
 						Repeated:      false,
 						descriptor:    fld.Field,
 						Type:          typ,
 						OneOf:         of,
+						Visibility:    visibilityTags(FieldVisibilityOption, fld.Options),
 					})
 				}
 				msg.OneOffs = append(msg.OneOffs, of)
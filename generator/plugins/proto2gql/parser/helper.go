@@ -3,10 +3,21 @@ package parser
 import (
 	"strconv"
 	"strings"
+	"unicode"
 
 	"github.com/emicklei/proto"
 )
 
+// Custom options with comma separated visibility tags, e.g. `[(go2gql.visibility) = "internal,admin"]`.
+// Protoc requires extensions names to be unique in package, so every options kind has it's own option.
+const (
+	FieldVisibilityOption     = "(go2gql.visibility)"
+	MessageVisibilityOption   = "(go2gql.message_visibility)"
+	MethodVisibilityOption    = "(go2gql.method_visibility)"
+	EnumVisibilityOption      = "(go2gql.enum_visibility)"
+	EnumValueVisibilityOption = "(go2gql.enum_value_visibility)"
+)
+
 func typeIsScalar(typ string) bool {
 	switch typ {
 	case "double", "float", "int32", "int64", "uint32", "uint64", "sint32", "sint64", "fixed32", "fixed64", "sfixed32", "sfixed64", "bool", "string", "bytes":
@@ -34,6 +45,61 @@ func quoteComment(comment *proto.Comment, inlineComment *proto.Comment) string {
 	return strconv.Quote(strings.TrimSpace(strings.Join(lines, "\n")))
 }
 
+// visibilityTags returns tags of visibility option with name.
+func visibilityTags(name string, options []*proto.Option) []string {
+	var res []string
+	for _, option := range options {
+		if option.Name != name {
+			continue
+		}
+		for _, tag := range strings.Split(option.Constant.Source, ",") {
+			if tag = strings.TrimSpace(tag); tag != "" {
+				res = append(res, tag)
+			}
+		}
+	}
+
+	return res
+}
+
+// jsonName returns value of field json_name option or JSON name, which protoc generates by default:
+// underscores are removed and letters after them are capitalized.
+func jsonName(name string, options []*proto.Option) string {
+	for _, option := range options {
+		if option.Name == "json_name" {
+			return option.Constant.Source
+		}
+	}
+	res := make([]rune, 0, len(name))
+	capitalizeNext := false
+	for _, r := range name {
+		if r == '_' {
+			capitalizeNext = true
+
+			continue
+		}
+		if capitalizeNext {
+			r = unicode.ToUpper(r)
+			capitalizeNext = false
+		}
+		res = append(res, r)
+	}
+
+	return string(res)
+}
+
+// elementsOptions returns options of message, enum, enum value or method body elements.
+func elementsOptions(elements []proto.Visitee) []*proto.Option {
+	var res []*proto.Option
+	for _, el := range elements {
+		if option, ok := el.(*proto.Option); ok {
+			res = append(res, option)
+		}
+	}
+
+	return res
+}
+
 func resolveFilePkgName(file *proto.Proto) string {
 	for _, el := range file.Elements {
 		if p, ok := el.(*proto.Package); ok {
@@ -52,6 +118,7 @@ func message(file *File, msg *proto.Message, typeName []string, parent *Message)
 		TypeName:      typeName,
 		file:          file,
 		parentMsg:     parent,
+		Visibility:    visibilityTags(MessageVisibilityOption, elementsOptions(msg.Elements)),
 	}
 
 	return m
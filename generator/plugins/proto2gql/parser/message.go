@@ -31,6 +31,7 @@ type Message struct {
 	TypeName      TypeName
 	file          *File
 	parentMsg     *Message
+	Visibility    []string // tags of visibility option
 }
 
 func (m Message) GetFields() []Field {
@@ -125,12 +126,14 @@ func (m Message) GetFullName() string {
 
 type Field interface {
 	GetName() string
+	GetJSONName() string
 	GetType() Type
 	IsRepeated() bool
 }
 
 type NormalField struct {
 	Name          string
+	JSONName      string // json_name option value or protoc default JSON name
 	QuotedComment string
 	Repeated      bool
 	descriptor    *proto.Field
@@ -138,12 +141,17 @@ type NormalField struct {
 	Optional      bool
 	Required      bool
 	OneOf         *OneOf
+	Visibility    []string // tags of visibility option
 }
 
 func (n *NormalField) GetName() string {
 	return n.Name
 }
 
+func (n *NormalField) GetJSONName() string {
+	return n.JSONName
+}
+
 func (n *NormalField) GetType() Type {
 	return n.Type
 }
@@ -153,15 +161,21 @@ func (n *NormalField) IsRepeated() bool {
 
 type MapField struct {
 	Name          string
+	JSONName      string // json_name option value or protoc default JSON name
 	QuotedComment string
 	descriptor    *proto.MapField
 	Map           *Map
+	Visibility    []string // tags of visibility option
 }
 
 func (n *MapField) GetName() string {
 	return n.Name
 }
 
+func (n *MapField) GetJSONName() string {
+	return n.JSONName
+}
+
 func (n *MapField) GetType() Type {
 	return n.Map
 }
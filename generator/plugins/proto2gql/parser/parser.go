@@ -10,11 +10,17 @@ import (
 
 type Parser struct {
 	parsedFiles []*File
+	sources     []string
 }
 
 func (p *Parser) ParsedFiles() []*File {
 	return p.parsedFiles
 }
+
+// Sources returns paths of all opened files, including files, which failed to parse.
+func (p *Parser) Sources() []string {
+	return p.sources
+}
 func (p *Parser) parsedFile(filePath string) (*File, bool) {
 	for _, f := range p.parsedFiles {
 		if f.FilePath == filePath {
@@ -76,6 +82,7 @@ func (p *Parser) Parse(path string, importAliases []map[string]string, paths []s
 	if pf, ok := p.parsedFile(absPath); ok {
 		return pf, nil
 	}
+	p.sources = append(p.sources, absPath)
 	file, err := os.Open(absPath)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to open File")
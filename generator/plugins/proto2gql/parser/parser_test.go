@@ -4,6 +4,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/emicklei/proto"
 	. "github.com/smartystreets/goconvey/convey"
 )
 
@@ -372,3 +373,41 @@ func CompareTypes(t1, t2 Type) {
 		panic("Undefined type")
 	}
 }
+
+func TestParser_ParseVisibility(t *testing.T) {
+	Convey("Test visibility options parsing", t, func() {
+		file, err := (&Parser{}).Parse("../../../../testdata/visibility.proto", nil, []string{"../../../../testdata"})
+		So(err, ShouldBeNil)
+
+		So(file.Services["Users"].Methods["GetUser"].Visibility, ShouldBeEmpty)
+		So(file.Services["Users"].Methods["DeleteUser"].Visibility, ShouldResemble, []string{"internal"})
+
+		user, audit := file.Messages[0], file.Messages[1]
+		So(user.Visibility, ShouldBeEmpty)
+		So(user.NormalFields[0].Visibility, ShouldBeEmpty)
+		So(user.NormalFields[1].Visibility, ShouldResemble, []string{"internal", "admin"})
+		So(user.MapFields[0].Visibility, ShouldResemble, []string{"internal"})
+		So(audit.Visibility, ShouldResemble, []string{"internal"})
+
+		status := file.Enums[0]
+		So(status.Visibility, ShouldResemble, []string{"public"})
+		So(status.Values[0].Visibility, ShouldBeEmpty)
+		So(status.Values[1].Visibility, ShouldResemble, []string{"internal"})
+	})
+}
+
+func TestParser_ParseJSONNames(t *testing.T) {
+	Convey("Test fields JSON names parsing", t, func() {
+		file, err := (&Parser{}).Parse("../../../../testdata/test.proto", nil, []string{"../../../../testdata"})
+		So(err, ShouldBeNil)
+
+		rootMessage := file.Messages[0]
+		So(rootMessage.NormalFields[0].JSONName, ShouldEqual, "rMsg")
+		So(rootMessage.MapFields[0].JSONName, ShouldEqual, "mapEnum")
+
+		Convey("json_name option should override default JSON name", func() {
+			So(jsonName("usr_nm", []*proto.Option{{Name: "json_name", Constant: proto.Literal{Source: "username"}}}), ShouldEqual, "username")
+			So(jsonName("usr_nm", nil), ShouldEqual, "usrNm")
+		})
+	})
+}
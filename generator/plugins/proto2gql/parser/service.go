@@ -12,4 +12,5 @@ type Method struct {
 	InputMessage  *Message
 	OutputMessage *Message
 	Service       *Service
+	Visibility    []string // tags of visibility option
 }
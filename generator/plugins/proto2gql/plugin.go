@@ -4,13 +4,11 @@ import (
 	"os"
 	"path/filepath"
 
-	"github.com/mitchellh/mapstructure"
 	"github.com/pkg/errors"
 
 	"github.com/EGT-Ukraine/go2gql/generator"
 	"github.com/EGT-Ukraine/go2gql/generator/plugins/dataloader"
 	"github.com/EGT-Ukraine/go2gql/generator/plugins/graphql"
-	"github.com/EGT-Ukraine/go2gql/generator/plugins/graphql/lib/pluginconfig"
 )
 
 const (
@@ -24,6 +22,8 @@ type Plugin struct {
 	dataLoaderPlugin *dataloader.Plugin
 	config           *Config
 	generateConfig   *generator.GenerateConfig
+	parsedFiles      []*parsedFile
+	proto2GraphQL    *Proto2GraphQL
 }
 
 func (p *Plugin) Init(config *generator.GenerateConfig, plugins []generator.Plugin) error {
@@ -43,7 +43,7 @@ func (p *Plugin) Init(config *generator.GenerateConfig, plugins []generator.Plug
 		return errors.New("'dataloader' plugin is not installed")
 	}
 	cfg := new(Config)
-	err := pluginconfig.Decode(config.PluginsConfigs[PluginConfigKey], cfg)
+	err := config.DecodePluginConfig(PluginConfigKey, cfg)
 	if err != nil {
 		return errors.Wrap(err, "failed to decode config")
 	}
@@ -65,7 +65,7 @@ func (p *Plugin) Init(config *generator.GenerateConfig, plugins []generator.Plug
 func (p *Plugin) parseImports() error {
 	for _, pluginsConfigsImports := range p.generateConfig.PluginsConfigsImports {
 		configs := new([]*ProtoFileConfig)
-		if err := mapstructure.Decode(pluginsConfigsImports.PluginsConfigs[PluginImportConfigKey], configs); err != nil {
+		if err := pluginsConfigsImports.DecodePluginConfig(PluginImportConfigKey, configs); err != nil {
 			return errors.Wrap(err, "failed to decode config")
 		}
 
@@ -120,12 +120,27 @@ func (p *Plugin) Infos() map[string]string {
 
 func (p *Plugin) Prepare() error {
 	pr := new(Proto2GraphQL)
+	p.proto2GraphQL = pr
 	pr.VendorPath = p.generateConfig.VendorPath
 	pr.DataLoaderPlugin = p.dataLoaderPlugin
 	pr.GenerateTracers = p.generateConfig.GenerateTraces
 	pr.OutputPath = p.config.GetOutputPath()
+	pr.FieldNaming = p.config.FieldNaming
+	if err := validateFieldNaming(pr.FieldNaming); err != nil {
+		return err
+	}
+	pr.Int64Format = p.config.Int64Format
+	if err := graphql.ValidateInt64Format(pr.Int64Format); err != nil {
+		return err
+	}
 	for _, file := range p.config.Files {
 		p.prepareFileConfig(file)
+		if err := validateFieldNaming(file.FieldNaming); err != nil {
+			return errors.Wrapf(err, "invalid file %s config", file.ProtoPath)
+		}
+		if err := graphql.ValidateInt64Format(file.Int64Format); err != nil {
+			return errors.Wrapf(err, "invalid file %s config", file.ProtoPath)
+		}
 
 		if err := pr.AddSourceByConfig(file); err != nil {
 			return errors.Wrap(err, "failed to parse file "+file.ProtoPath)
@@ -143,14 +158,47 @@ func (p *Plugin) Prepare() error {
 		}
 		p.graphql.AddTypesFile(pf.OutputPath, commonFile)
 	}
+	p.parsedFiles = pr.ParsedFiles
 
 	return nil
 }
 
+// Sources returns configured proto files and all their imports.
+func (p *Plugin) Sources() []string {
+	var res []string
+	if p.config != nil {
+		for _, file := range p.config.Files {
+			res = append(res, file.ProtoPath)
+		}
+	}
+	if p.proto2GraphQL != nil {
+		res = append(res, p.proto2GraphQL.parser.Sources()...)
+	}
+
+	return res
+}
+
+// OutputRoots returns configured output paths.
+func (p *Plugin) OutputRoots() []string {
+	if p.config == nil {
+		return nil
+	}
+	res := []string{p.config.OutputPath}
+	for _, file := range p.config.Files {
+		res = append(res, file.OutputPath)
+	}
+
+	return res
+}
+
 func (Plugin) Name() string {
 	return PluginName
 }
 
+func (Plugin) Dependencies() []string {
+	return []string{graphql.PluginName, dataloader.PluginName}
+}
+
 func (Plugin) Generate() error {
 	return nil
 }
@@ -21,11 +21,10 @@ func (g *Proto2GraphQL) FieldOutputGraphQLTypeResolver(message *parser.Message,
 
 	switch pType := field.GetType().(type) {
 	case *parser.Scalar:
-		resolver, ok := scalarsResolvers[pType.ScalarName]
-		if !ok {
-			return nil, errors.Errorf("unimplemented scalar type: %s", pType.ScalarName)
+		result, err = g.fieldScalarTypeResolver(fieldTypeFile, message, field, pType)
+		if err != nil {
+			return nil, err
 		}
-		result = resolver
 
 	case *parser.Message:
 		messageConfig, err := fieldTypeFile.Config.MessageConfig(pType.Name)
@@ -77,11 +76,7 @@ func (g *Proto2GraphQL) FieldOutputGraphQLTypeResolver(message *parser.Message,
 func (g *Proto2GraphQL) TypeOutputGraphQLTypeResolver(typeFile *parsedFile, typ parser.Type) (graphql.TypeResolver, error) {
 	switch pType := typ.(type) {
 	case *parser.Scalar:
-		resolver, ok := scalarsResolvers[pType.ScalarName]
-		if !ok {
-			return nil, errors.Errorf("unimplemented scalar type: %s", pType.ScalarName)
-		}
-		return resolver, nil
+		return scalarTypeResolver(pType, g.int64Format(typeFile.File))
 	case *parser.Message:
 		msgCfg, err := typeFile.Config.MessageConfig(pType.Name)
 		if err != nil {
@@ -112,11 +107,7 @@ func (g *Proto2GraphQL) TypeOutputGraphQLTypeResolver(typeFile *parsedFile, typ
 func (g *Proto2GraphQL) TypeInputGraphQLTypeResolver(typeFile *parsedFile, typ parser.Type) (graphql.TypeResolver, error) {
 	switch pType := typ.(type) {
 	case *parser.Scalar:
-		resolver, ok := scalarsResolvers[pType.ScalarName]
-		if !ok {
-			return nil, errors.Errorf("unimplemented scalar type: %s", typ.(*parser.Scalar).ScalarName)
-		}
-		return resolver, nil
+		return scalarTypeResolver(pType, g.int64Format(typeFile.File))
 	case *parser.Message:
 		res := g.inputMessageTypeResolver(typeFile, pType)
 
@@ -177,6 +168,26 @@ func (g *Proto2GraphQL) TypeValueResolver(typeFile *parsedFile, typ parser.Type,
 			return resolver
 		}, false, true, nil
 	case *parser.Enum:
+		mode, _, err := g.enumUnknownValue(pType)
+		if err != nil {
+			return nil, false, false, err
+		}
+		if mode == UnknownEnumValueFallback {
+			// fallback value can't be passed to backends, so it's rejected by enums.Input
+			return func(arg string, ctx graphql.BodyContext) string {
+				goTyp := ctx.Importer.Prefix(typeFile.GRPCSourcesPkg) + snakeCamelCaseSlice(pType.TypeName)
+				resTyp, res := goTyp, "res"
+				if ptr {
+					resTyp, res = "*"+goTyp, "&res"
+				}
+
+				return "func(arg interface{}) (" + resTyp + ", error) {\n" +
+					"val, err := " + ctx.Importer.New(graphql.EnumsPkgPath) + ".Input(arg)\n" +
+					"res := " + goTyp + "(val)\n" +
+					"return " + res + ", err\n" +
+					"}(" + arg + ")"
+			}, true, true, nil
+		}
 		return func(arg string, ctx graphql.BodyContext) string {
 			goTyp := ctx.Importer.Prefix(typeFile.GRPCSourcesPkg) + snakeCamelCaseSlice(pType.TypeName)
 			resolver := goTyp + "(" + arg + ".(int))"
@@ -268,7 +279,7 @@ func (g *Proto2GraphQL) FieldOutputValueResolver(message *parser.Message, fieldN
 			if err != nil {
 				return nil, errors.Wrap(err, "failed to resolve field go type")
 			}
-			return func(arg string, ctx graphql.BodyContext) string {
+			result = func(arg string, ctx graphql.BodyContext) string {
 
 				return "func(arg []" + goTyp.String(ctx.Importer) + ") []int {" +
 					"\n  	res := make([]int, len(arg))" +
@@ -277,12 +288,14 @@ func (g *Proto2GraphQL) FieldOutputValueResolver(message *parser.Message, fieldN
 					"\n		}" +
 					"\n 	return res" +
 					"\n	}(" + arg + ".Get" + camelCase(fieldName) + "())"
-			}, nil
+			}
+		} else {
+			result = func(arg string, ctx graphql.BodyContext) string {
+				return "int(" + arg + ".Get" + camelCase(fieldName) + "())"
+			}
 		}
 
-		return func(arg string, ctx graphql.BodyContext) string {
-			return "int(" + arg + ".Get" + camelCase(fieldName) + "())"
-		}, nil
+		return g.enumUnknownValueResolver(ft, field.IsRepeated(), result)
 	default:
 		return nil, errors.Errorf("can't build output value resolver for field of type %v", field.GetType().Kind())
 	}
@@ -290,6 +303,38 @@ func (g *Proto2GraphQL) FieldOutputValueResolver(message *parser.Message, fieldN
 	return result, nil
 }
 
+// enumUnknownValueResolver wraps enum values resolver with resolving of values, which are unknown to generated enum.
+func (g *Proto2GraphQL) enumUnknownValueResolver(enum *parser.Enum, repeated bool, resolver graphql.ValueResolver) (graphql.ValueResolver, error) {
+	mode, _, err := g.enumUnknownValue(enum)
+	if err != nil {
+		return nil, err
+	}
+	if mode == "" {
+		return resolver, nil
+	}
+	enumFile, err := g.parsedFile(enum.File())
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to resolve enum %s parsed file", enum.Name)
+	}
+	var function, params string
+	switch {
+	case mode == UnknownEnumValueFallback && repeated:
+		function = "Values"
+	case mode == UnknownEnumValueFallback:
+		function = "Value"
+	case repeated:
+		function, params = "ReportValues", "p, "
+	default:
+		function, params = "Report", "p, "
+	}
+
+	return func(arg string, ctx graphql.BodyContext) string {
+		enumVariable := ctx.Importer.Prefix(enumFile.OutputPkg) + g.enumVariable(enumFile, enum)
+
+		return ctx.Importer.New(graphql.EnumsPkgPath) + "." + function + "(" + params + enumVariable + ", " + resolver(arg, ctx) + ")"
+	}, nil
+}
+
 func optionalValueResolver(goTyp, valueResolver, arg string) string {
 	return "func(arg interface{}) *" + goTyp + "{\n" +
 		"val := " + valueResolver + "\n" +
@@ -0,0 +1,50 @@
+package proto2gql
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/EGT-Ukraine/go2gql/generator/plugins/graphql"
+	"github.com/EGT-Ukraine/go2gql/generator/plugins/proto2gql/parser"
+)
+
+// int64Format returns 64-bit integers format of proto file.
+func (g *Proto2GraphQL) int64Format(file *parser.File) string {
+	if cfg := g.fileConfig(file); cfg != nil && cfg.Int64Format != "" {
+		return cfg.Int64Format
+	}
+
+	return g.Int64Format
+}
+
+// scalarTypeResolver returns GraphQL type resolver of scalar. 64-bit integers are resolved to string scalars in string format.
+func scalarTypeResolver(scalar *parser.Scalar, int64Format string) (graphql.TypeResolver, error) {
+	if int64Format == graphql.Int64FormatString {
+		if resolver, ok := int64StringScalarsResolvers[scalar.ScalarName]; ok {
+			return resolver, nil
+		}
+	}
+	resolver, ok := scalarsResolvers[scalar.ScalarName]
+	if !ok {
+		return nil, errors.Errorf("unimplemented scalar type: %s", scalar.ScalarName)
+	}
+
+	return resolver, nil
+}
+
+// fieldScalarTypeResolver returns GraphQL type resolver of message scalar field. Field int64_format config overrides
+// file one.
+func (g *Proto2GraphQL) fieldScalarTypeResolver(typeFile *parsedFile, msg *parser.Message, field parser.Field, scalar *parser.Scalar) (graphql.TypeResolver, error) {
+	msgCfg, err := g.fileConfig(msg.File()).MessageConfig(msg.Name)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to resolve message %s config", msg.Name)
+	}
+	format := msgCfg.Fields[field.GetName()].Int64Format
+	if err := graphql.ValidateInt64Format(format); err != nil {
+		return nil, errors.Wrapf(err, "invalid message %s field %s config", msg.Name, field.GetName())
+	}
+	if format == "" {
+		format = g.int64Format(typeFile.File)
+	}
+
+	return scalarTypeResolver(scalar, format)
+}
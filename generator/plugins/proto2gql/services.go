@@ -11,19 +11,34 @@ import (
 	"github.com/EGT-Ukraine/go2gql/generator/plugins/proto2gql/parser"
 )
 
-func (g Proto2GraphQL) serviceMethodArguments(file *parsedFile, method *parser.Method) ([]graphql.MethodArgument, error) {
+func (g Proto2GraphQL) serviceMethodArguments(cfg MethodConfig, file *parsedFile, method *parser.Method) ([]graphql.MethodArgument, error) {
 	var args []graphql.MethodArgument
 
 	messageFields, err := g.getMessageFields(file, method.InputMessage)
 	if err != nil {
 		return nil, err
 	}
+	msgCfg, err := g.fileConfig(method.InputMessage.File()).MessageConfig(method.InputMessage.Name)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to resolve message %s config", method.InputMessage.Name)
+	}
+
+	masksFields := make(map[string]bool)
+	for _, fld := range method.InputMessage.NormalFields {
+		if fld.Name == cfg.ReadMaskField || fld.Name == cfg.UpdateMaskField {
+			masksFields[g.fieldName(method.InputMessage, msgCfg, fld)] = true
+		}
+	}
 
 	for _, messageField := range messageFields {
+		if masksFields[messageField.Name] {
+			continue
+		}
 		args = append(args, graphql.MethodArgument{
 			Name:          messageField.Name,
 			Type:          messageField.Type,
 			QuotedComment: messageField.QuotedComment,
+			Visibility:    messageField.Visibility,
 		})
 	}
 
@@ -97,12 +112,12 @@ func (g Proto2GraphQL) messagePayloadErrorParams(message *parser.Message) (check
 	return nil, nil, nil
 }
 
-func (g Proto2GraphQL) methodName(cfg MethodConfig, method *parser.Method) string {
+func (g Proto2GraphQL) methodName(cfg MethodConfig, file *parsedFile, method *parser.Method) string {
 	if cfg.Alias != "" {
 		return cfg.Alias
 	}
 
-	return method.Name
+	return g.methodFieldName(file.File, method)
 }
 
 func (g Proto2GraphQL) serviceMethod(sc ServiceConfig, cfg MethodConfig, file *parsedFile, method *parser.Method) (*graphql.Method, error) {
@@ -114,6 +129,12 @@ func (g Proto2GraphQL) serviceMethod(sc ServiceConfig, cfg MethodConfig, file *p
 	clientMethodCaller := func(client, arg string, ctx graphql.BodyContext) string {
 		return client + "." + camelCase(method.Name) + "(ctx," + arg + ")"
 	}
+	if len(cfg.PrimeLoaders) > 0 {
+		clientMethodCaller, err = g.primeLoadersClientMethodCaller(cfg, method)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to build data loaders priming method caller")
+		}
+	}
 
 	var outProtoType parser.Type
 	var outType graphql.TypeResolver
@@ -146,9 +167,10 @@ func (g Proto2GraphQL) serviceMethod(sc ServiceConfig, cfg MethodConfig, file *p
 			return nil, errors.Wrap(err, "failed to build output type resovler")
 		}
 
+		methodCaller := clientMethodCaller
 		clientMethodCaller = func(client, arg string, ctx graphql.BodyContext) string {
 			return `func() (interface{}, error) {
-				res, err :=  ` + client + "." + camelCase(method.Name) + `(ctx,` + arg + `)
+				res, err :=  ` + methodCaller(client, arg, ctx) + `
 
 				if err != nil {
 					return nil, err
@@ -181,7 +203,7 @@ func (g Proto2GraphQL) serviceMethod(sc ServiceConfig, cfg MethodConfig, file *p
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to get request go type for method: %s", method.Name)
 	}
-	args, err := g.serviceMethodArguments(file, method)
+	args, err := g.serviceMethodArguments(cfg, file, method)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to prepare service method arguments")
 	}
@@ -197,6 +219,20 @@ func (g Proto2GraphQL) serviceMethod(sc ServiceConfig, cfg MethodConfig, file *p
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to resolve message value resolver")
 	}
+	if cfg.ReadMaskField != "" {
+		valueResolver, err = g.readMaskRequestResolver(method, cfg, valueResolver)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to build read mask request resolver")
+		}
+		valueResolverWithErr = true
+	}
+	if cfg.UpdateMaskField != "" {
+		valueResolver, err = g.updateMaskRequestResolver(method, cfg, valueResolver)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to build update mask request resolver")
+		}
+		valueResolverWithErr = true
+	}
 
 	if err := g.registerMethodDataLoaders(sc, cfg, file, method); err != nil {
 		return nil, errors.Wrap(err, "failed add data loader provider")
@@ -204,7 +240,7 @@ func (g Proto2GraphQL) serviceMethod(sc ServiceConfig, cfg MethodConfig, file *p
 
 	return &graphql.Method{
 		OriginalName:           method.Name,
-		Name:                   g.methodName(cfg, method),
+		Name:                   g.methodName(cfg, file, method),
 		QuotedComment:          method.QuotedComment,
 		GraphQLOutputType:      outType,
 		RequestType:            requestType,
@@ -214,12 +250,15 @@ func (g Proto2GraphQL) serviceMethod(sc ServiceConfig, cfg MethodConfig, file *p
 		Arguments:              args,
 		PayloadErrorChecker:    payloadErrChecker,
 		PayloadErrorAccessor:   payloadErrAccessor,
+		Auth:                   cfg.Auth,
+		Visibility:             mergeTags(method.Visibility, cfg.Visibility),
 	}, nil
 }
 
 func (g Proto2GraphQL) serviceQueryMethods(sc ServiceConfig, file *parsedFile, service *parser.Service) ([]graphql.Method, error) {
 	var res []graphql.Method
-	for methodName, methodConfig := range sc.Methods {
+	for _, methodName := range sc.methodsNames() {
+		methodConfig := sc.Methods[methodName]
 		method, ok := service.Methods[methodName]
 		if !ok {
 			return nil, errors.Errorf("Method with name '%s' not found in service '%s'", methodName, service.Name)
@@ -252,7 +291,8 @@ func (g Proto2GraphQL) methodIsQuery(cfg MethodConfig, method *parser.Method) bo
 
 func (g Proto2GraphQL) serviceMutationsMethods(cfg ServiceConfig, file *parsedFile, service *parser.Service) ([]graphql.Method, error) {
 	var res []graphql.Method
-	for methodName, methodConfig := range cfg.Methods {
+	for _, methodName := range cfg.methodsNames() {
+		methodConfig := cfg.Methods[methodName]
 		method, ok := service.Methods[methodName]
 		if !ok {
 			return nil, errors.Errorf("Method with name '%s' not found in service '%s'", methodName, service.Name)
@@ -282,7 +322,8 @@ func (g Proto2GraphQL) serviceName(sc ServiceConfig, service *parser.Service) st
 
 func (g Proto2GraphQL) fileServices(file *parsedFile) ([]graphql.Service, error) {
 	var res []graphql.Service
-	for serviceName, sc := range file.Config.GetServices() {
+	for _, serviceName := range file.Config.servicesNames() {
+		sc := file.Config.Services[serviceName]
 		service, ok := file.File.Services[serviceName]
 		if !ok {
 			return nil, errors.Errorf("Service '%s' not found in file '%s'", serviceName, file.File.FilePath)
@@ -296,6 +337,12 @@ func (g Proto2GraphQL) fileServices(file *parsedFile) ([]graphql.Service, error)
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to resolve service methods")
 		}
+		if err := checkMethodsNamesCollisions("service "+service.Name+" queries", queryMethods); err != nil {
+			return nil, err
+		}
+		if err := checkMethodsNamesCollisions("service "+service.Name+" mutations", mutationsMethods); err != nil {
+			return nil, err
+		}
 
 		res = append(res, graphql.Service{
 			OriginalName:    service.Name,
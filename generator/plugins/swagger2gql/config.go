@@ -2,32 +2,67 @@ package swagger2gql
 
 import (
 	"regexp"
+	"sort"
+	"strconv"
 	"time"
 
 	"github.com/pkg/errors"
 
 	"github.com/EGT-Ukraine/go2gql/generator/plugins/dataloader"
+	"github.com/EGT-Ukraine/go2gql/generator/plugins/graphql"
 	"github.com/EGT-Ukraine/go2gql/generator/plugins/graphql/lib/names"
 )
 
 type FieldConfig struct {
-	ContextKey string `mapstructure:"context_key"`
+	ContextKey  string   `mapstructure:"context_key"`
+	Visibility  []string `mapstructure:"visibility"`   // visibility tags
+	Exclude     bool     `mapstructure:"exclude"`      // exclude field from GraphQL input and output objects
+	Name        string   `mapstructure:"name"`         // GraphQL field name, property name by default
+	Description string   `mapstructure:"description"`  // GraphQL field description, property description by default
+	Int64Format string   `mapstructure:"int64_format"` // overrides file int64_format for 64-bit integer field
+}
+
+// graphQLName returns GraphQL name of field with property name.
+func (fc FieldConfig) graphQLName(propertyName string) string {
+	if fc.Name != "" {
+		return fc.Name
+	}
+
+	return names.FilterNotSupportedFieldNameCharacters(propertyName)
+}
+
+// quotedComment returns quoted GraphQL description of field with property description.
+func (fc FieldConfig) quotedComment(description string) string {
+	if fc.Description != "" {
+		return strconv.Quote(fc.Description)
+	}
+
+	return strconv.Quote(description)
 }
+
 type ObjectConfig struct {
 	Fields      map[string]FieldConfig   `mapstructure:"fields"`
 	DataLoaders []dataloader.FieldConfig `mapstructure:"data_loaders"`
+	Node        *graphql.NodeConfig      `mapstructure:"node"`       // declares object as relay node
+	Visibility  []string                 `mapstructure:"visibility"` // visibility tags
 }
 
 type MethodConfig struct {
-	Alias              string         `mapstructure:"alias"`
-	RequestType        string         `mapstructure:"request_type"` // QUERY | MUTATION
-	DataLoaderProvider ProviderConfig `mapstructure:"data_loader_provider"`
+	Alias              string              `mapstructure:"alias"`
+	RequestType        string              `mapstructure:"request_type"` // QUERY | MUTATION
+	DataLoaderProvider ProviderConfig      `mapstructure:"data_loader_provider"`
+	Auth               *graphql.AuthConfig `mapstructure:"auth"`       // authorization policy, checked by interceptors.Authorizer
+	Visibility         []string            `mapstructure:"visibility"` // visibility tags
 }
 
 type ProviderConfig struct {
 	Name         string        `mapstructure:"name"`
 	WaitDuration time.Duration `mapstructure:"wait_duration"`
 	Slice        bool
+	MaxBatch     int           `mapstructure:"max_batch"`
+	Cache        string        `mapstructure:"cache"` // none | request | ttl
+	CacheTTL     time.Duration `mapstructure:"cache_ttl"`
+	Lazy         bool          `mapstructure:"lazy"`
 }
 
 type TagConfig struct {
@@ -36,9 +71,18 @@ type TagConfig struct {
 	Methods         map[string]map[string]MethodConfig `mapstructure:"methods"`
 }
 type Config struct {
-	Files      []*SwaggerFileConfig      `mapstructure:"files"`
-	OutputPath string                    `mapstructure:"output_path"`
-	Messages   []map[string]ObjectConfig `mapstructure:"messages"`
+	Files       []*SwaggerFileConfig      `mapstructure:"files"`
+	OutputPath  string                    `mapstructure:"output_path"`
+	Messages    []map[string]ObjectConfig `mapstructure:"messages"`
+	Int64Format string                    `mapstructure:"int64_format"` // number|string. 64-bit integers serialization. Default: number
+}
+
+func (c *Config) GetInt64Format() string {
+	if c == nil {
+		return ""
+	}
+
+	return c.Int64Format
 }
 
 func (c *Config) GetOutputPath() string {
@@ -66,32 +110,58 @@ type SwaggerFileConfig struct {
 
 	GQLObjectsPrefix string `mapstructure:"gql_objects_prefix"`
 
+	Int64Format string `mapstructure:"int64_format"` // overrides global int64_format for file
+
 	Tags         map[string]*TagConfig     `mapstructure:"tags"`
 	Objects      []map[string]ObjectConfig `mapstructure:"objects"`
 	ParamsConfig []ParamConfig             `mapstructure:"params_config"`
+
+	matchedEntries map[string]struct{} // objects regexes, fields and params, which matched something during preparation
 }
 
 func (pc *SwaggerFileConfig) ObjectConfig(objName string) (ObjectConfig, error) {
+	_, cfg, err := pc.objectConfig(objName)
+
+	return cfg, err
+}
+
+// objectConfig returns first object config, which regex matches object name, and the regex.
+func (pc *SwaggerFileConfig) objectConfig(objName string) (string, ObjectConfig, error) {
 	if pc == nil {
-		return ObjectConfig{}, nil
+		return "", ObjectConfig{}, nil
 	}
 	for _, cfgs := range pc.Objects {
-		for msgNameRegex, cfg := range cfgs {
+		for _, msgNameRegex := range objectsConfigsRegexes(cfgs) {
+			cfg := cfgs[msgNameRegex]
 			r, err := regexp.Compile(msgNameRegex)
 			if err != nil {
-				return ObjectConfig{}, errors.Wrapf(err, "failed to compile object name regex '%s'", msgNameRegex)
+				return "", ObjectConfig{}, errors.Wrapf(err, "failed to compile object name regex '%s'", msgNameRegex)
 			}
 			if r.MatchString(objName) {
-				return cfg, nil
+				pc.markMatched(objectConfigEntry(msgNameRegex))
+
+				return msgNameRegex, cfg, nil
 			}
 		}
 	}
 
-	return ObjectConfig{}, nil
+	return "", ObjectConfig{}, nil
+}
+
+// objectsConfigsRegexes returns sorted objects names regexes of objects configs item,
+// so the first matched config doesn't depend on map iteration order.
+func objectsConfigsRegexes(cfgs map[string]ObjectConfig) []string {
+	res := make([]string, 0, len(cfgs))
+	for regex := range cfgs {
+		res = append(res, regex)
+	}
+	sort.Strings(res)
+
+	return res
 }
 
 func (pc *SwaggerFileConfig) FieldConfig(objName string, fieldName string) (FieldConfig, error) {
-	cfg, err := pc.ObjectConfig(objName)
+	objRegex, cfg, err := pc.objectConfig(objName)
 
 	if err != nil {
 		return FieldConfig{}, errors.Wrap(err, "failed to resolve property config")
@@ -103,12 +173,16 @@ func (pc *SwaggerFileConfig) FieldConfig(objName string, fieldName string) (Fiel
 		paramCfg, ok := cfg.Fields[paramGqlName]
 
 		if ok {
+			pc.markMatched(objectFieldConfigEntry(objRegex, paramGqlName))
+
 			return paramCfg, nil
 		}
 	}
 
 	for _, paramConfig := range pc.ParamsConfig {
 		if paramConfig.ParamName == fieldName {
+			pc.markMatched(paramConfigEntry(paramConfig.ParamName))
+
 			return FieldConfig{ContextKey: paramConfig.ContextKey}, nil
 		}
 	}
@@ -116,6 +190,19 @@ func (pc *SwaggerFileConfig) FieldConfig(objName string, fieldName string) (Fiel
 	return FieldConfig{}, nil
 }
 
+func (pc *SwaggerFileConfig) markMatched(entry string) {
+	if pc.matchedEntries == nil {
+		pc.matchedEntries = make(map[string]struct{})
+	}
+	pc.matchedEntries[entry] = struct{}{}
+}
+
+func (pc *SwaggerFileConfig) matched(entry string) bool {
+	_, ok := pc.matchedEntries[entry]
+
+	return ok
+}
+
 func (pc *SwaggerFileConfig) GetName() string {
 	if pc == nil {
 		return ""
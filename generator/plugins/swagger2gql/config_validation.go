@@ -0,0 +1,93 @@
+package swagger2gql
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/EGT-Ukraine/go2gql/generator"
+	"github.com/EGT-Ukraine/go2gql/generator/plugins/swagger2gql/parser"
+)
+
+func objectConfigEntry(objRegex string) string {
+	return fmt.Sprintf("objects regex '%s'", objRegex)
+}
+
+func objectFieldConfigEntry(objRegex, fieldName string) string {
+	return fmt.Sprintf("field '%s' of objects regex '%s'", fieldName, objRegex)
+}
+
+func paramConfigEntry(paramName string) string {
+	return fmt.Sprintf("param '%s'", paramName)
+}
+
+// ValidateConfig reports tags, methods, objects regexes and params configs, which matched nothing in swagger files.
+func (p *Plugin) ValidateConfig() error {
+	var entries []string
+	for _, file := range p.parsedFiles {
+		for _, entry := range unmatchedFileConfigEntries(file) {
+			entries = append(entries, file.Config.Path+": "+entry+" matched nothing")
+		}
+	}
+	if len(entries) > 0 {
+		sort.Strings(entries)
+
+		return generator.UnmatchedConfigEntriesError{Entries: entries}
+	}
+
+	return nil
+}
+
+func unmatchedFileConfigEntries(file *parsedFile) []string {
+	var res []string
+
+	for tagName, tagCfg := range file.Config.Tags {
+		var tag *parser.Tag
+		for i := range file.File.Tags {
+			if file.File.Tags[i].Name == tagName {
+				tag = &file.File.Tags[i]
+			}
+		}
+		if tag == nil {
+			res = append(res, fmt.Sprintf("tag '%s'", tagName))
+
+			continue
+		}
+		for path, methods := range tagCfg.Methods {
+			for httpMethod := range methods {
+				found := false
+				for _, method := range tag.Methods {
+					if method.Path == path && strings.EqualFold(method.HTTPMethod, httpMethod) {
+						found = true
+					}
+				}
+				if !found {
+					res = append(res, fmt.Sprintf("tag '%s' method '%s %s'", tagName, httpMethod, path))
+				}
+			}
+		}
+	}
+
+	for _, cfgs := range file.Config.Objects {
+		for objRegex, cfg := range cfgs {
+			if !file.Config.matched(objectConfigEntry(objRegex)) {
+				res = append(res, objectConfigEntry(objRegex))
+
+				continue
+			}
+			for fieldName := range cfg.Fields {
+				if !file.Config.matched(objectFieldConfigEntry(objRegex, fieldName)) {
+					res = append(res, objectFieldConfigEntry(objRegex, fieldName))
+				}
+			}
+		}
+	}
+
+	for _, param := range file.Config.ParamsConfig {
+		if !file.Config.matched(paramConfigEntry(param.ParamName)) {
+			res = append(res, paramConfigEntry(param.ParamName))
+		}
+	}
+
+	return res
+}
@@ -7,7 +7,6 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/EGT-Ukraine/go2gql/generator/plugins/graphql"
-	"github.com/EGT-Ukraine/go2gql/generator/plugins/graphql/lib/names"
 	"github.com/EGT-Ukraine/go2gql/generator/plugins/swagger2gql/parser"
 )
 
@@ -27,20 +26,23 @@ func (p *Plugin) methodParametersInputObjectResolver(file *parsedFile, tag strin
 		// if err != nil {
 		// 	return nil, errors.Wrap(err, "failed to resolve parameter go type")
 		// }
-		paramGqlName := names.FilterNotSupportedFieldNameCharacters(param.Name)
 		paramCfg, err := file.Config.FieldConfig(gqlName, param.Name)
 
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to resolve property config")
 		}
 
+		if paramCfg.Exclude && paramCfg.ContextKey == "" {
+			continue
+		}
+
 		valueResolver, withErr, fromArgs, err := p.TypeValueResolver(file, param.Type, !param.Required, paramCfg.ContextKey)
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to get parameter value resolver")
 		}
 		fields = append(fields, graphql.InputObjectResolverField{
 			OutputFieldName:       pascalize(param.Name),
-			GraphQLInputFieldName: paramGqlName,
+			GraphQLInputFieldName: paramCfg.graphQLName(param.Name),
 			ValueResolver:         valueResolver,
 			ResolverWithError:     withErr,
 			IsFromArgs:            fromArgs,
@@ -80,8 +82,6 @@ func (p *Plugin) fileInputMessagesResolvers(file *parsedFile) ([]graphql.InputOb
 			gqlObjName := p.inputObjectGQLName(file, t)
 			handledObjects[t] = struct{}{}
 			for _, property := range t.Properties {
-				gqlName := names.FilterNotSupportedFieldNameCharacters(property.Name)
-
 				paramCfg, err := file.Config.FieldConfig(gqlObjName, property.Name)
 				if err != nil {
 					return errors.Wrapf(err, "failed to resolve property %s config", property.Name)
@@ -91,12 +91,15 @@ func (p *Plugin) fileInputMessagesResolvers(file *parsedFile) ([]graphql.InputOb
 				if err != nil {
 					return errors.Wrapf(err, "failed to resolve property %s objects resolvers", property.Name)
 				}
+				if paramCfg.Exclude && paramCfg.ContextKey == "" {
+					continue
+				}
 				valueResolver, withErr, fromArgs, err := p.TypeValueResolver(file, property.Type, property.Required, paramCfg.ContextKey)
 				if err != nil {
 					return errors.Wrap(err, "failed to get property value resolver")
 				}
 				fields = append(fields, graphql.InputObjectResolverField{
-					GraphQLInputFieldName: gqlName,
+					GraphQLInputFieldName: paramCfg.graphQLName(property.Name),
 					OutputFieldName:       pascalize(property.Name),
 					ValueResolver:         valueResolver,
 					ResolverWithError:     withErr,
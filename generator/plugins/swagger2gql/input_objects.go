@@ -2,13 +2,11 @@ package swagger2gql
 
 import (
 	"sort"
-	"strconv"
 	"strings"
 
 	"github.com/pkg/errors"
 
 	"github.com/EGT-Ukraine/go2gql/generator/plugins/graphql"
-	"github.com/EGT-Ukraine/go2gql/generator/plugins/graphql/lib/names"
 	"github.com/EGT-Ukraine/go2gql/generator/plugins/swagger2gql/parser"
 )
 
@@ -43,18 +41,19 @@ func (p *Plugin) fileInputObjects(file *parsedFile) ([]graphql.InputObject, erro
 				return nil
 			}
 			handledObjects[typ] = struct{}{}
+			gqlObjName := p.inputObjectGQLName(file, t)
 			var fields []graphql.ObjectField
 			for _, property := range t.Properties {
 				if err := handleType(property.Type); err != nil {
 					return err
 				}
 
-				paramCfg, err := file.Config.FieldConfig(t.Name, property.Name)
+				paramCfg, err := file.Config.FieldConfig(gqlObjName, property.Name)
 				if err != nil {
 					return errors.Wrap(err, "failed to resolve property config")
 				}
 
-				if paramCfg.ContextKey != "" {
+				if paramCfg.ContextKey != "" || paramCfg.Exclude {
 					continue
 				}
 
@@ -62,23 +61,33 @@ func (p *Plugin) fileInputObjects(file *parsedFile) ([]graphql.InputObject, erro
 				if err != nil {
 					return errors.Wrap(err, "failed to get input type resolver")
 				}
+				typeResolver, err = propertyTypeResolver(paramCfg, property.Type, typeResolver)
+				if err != nil {
+					return errors.Wrapf(err, "invalid object %s property %s config", gqlObjName, property.Name)
+				}
 				if property.Required {
 					typeResolver = graphql.GqlNonNullTypeResolver(typeResolver)
 				}
 				fields = append(fields, graphql.ObjectField{
-					Name:          names.FilterNotSupportedFieldNameCharacters(property.Name),
+					Name:          paramCfg.graphQLName(property.Name),
 					Type:          typeResolver,
-					QuotedComment: strconv.Quote(property.Description),
+					QuotedComment: paramCfg.quotedComment(property.Description),
 					NeedCast:      false,
+					Visibility:    paramCfg.Visibility,
 				})
 			}
 			sort.Slice(fields, func(i, j int) bool {
 				return fields[i].Name > fields[j].Name
 			})
+			objectCfg, err := file.Config.ObjectConfig(t.Name)
+			if err != nil {
+				return errors.Wrap(err, "failed to resolve object config")
+			}
 			res = append(res, graphql.InputObject{
 				VariableName: p.inputObjectVariable(file, t),
-				GraphQLName:  p.inputObjectGQLName(file, t),
+				GraphQLName:  gqlObjName,
 				Fields:       fields,
+				Visibility:   objectCfg.Visibility,
 			})
 
 		case *parser.Array:
@@ -2,14 +2,12 @@ package swagger2gql
 
 import (
 	"sort"
-	"strconv"
 	"strings"
 
 	"github.com/pkg/errors"
 
 	"github.com/EGT-Ukraine/go2gql/generator/plugins/dataloader"
 	"github.com/EGT-Ukraine/go2gql/generator/plugins/graphql"
-	"github.com/EGT-Ukraine/go2gql/generator/plugins/graphql/lib/names"
 	"github.com/EGT-Ukraine/go2gql/generator/plugins/swagger2gql/parser"
 )
 
@@ -49,13 +47,25 @@ func (p *Plugin) fileOutputMessages(file *parsedFile) ([]graphql.OutputObject, e
 			if err != nil {
 				return errors.Wrap(err, "failed to resolve object go type")
 			}
+			objectName := p.outputObjectGQLName(file, t)
 			var fields []graphql.ObjectField
 			var mapFields []graphql.ObjectField
 			for _, prop := range t.Properties {
+				propCfg, err := file.Config.FieldConfig(objectName, prop.Name)
+				if err != nil {
+					return errors.Wrap(err, "failed to resolve property config")
+				}
+				if propCfg.Exclude {
+					continue
+				}
 				tr, err := p.TypeOutputTypeResolver(file, prop.Type, false)
 				if err != nil {
 					return errors.Wrap(err, "failed to resolve property output type resolver")
 				}
+				tr, err = propertyTypeResolver(propCfg, prop.Type, tr)
+				if err != nil {
+					return errors.Wrapf(err, "invalid object %s property %s config", objectName, prop.Name)
+				}
 				valueResolver := graphql.IdentAccessValueResolver(pascalize(prop.Name))
 				if typ == parser.ObjDateTime {
 					switch prop.Name {
@@ -77,12 +87,13 @@ func (p *Plugin) fileOutputMessages(file *parsedFile) ([]graphql.OutputObject, e
 				}
 
 				propObj := graphql.ObjectField{
-					Name:          names.FilterNotSupportedFieldNameCharacters(prop.Name),
-					QuotedComment: strconv.Quote(prop.Description),
+					Name:          propCfg.graphQLName(prop.Name),
+					QuotedComment: propCfg.quotedComment(prop.Description),
 					Type:          tr,
 					Value:         valueResolver,
 					NeedCast:      false,
 					GoType:        propGoType,
+					Visibility:    propCfg.Visibility,
 				}
 				if prop.Type.Kind() == parser.KindMap {
 					mapFields = append(mapFields, propObj)
@@ -95,14 +106,13 @@ func (p *Plugin) fileOutputMessages(file *parsedFile) ([]graphql.OutputObject, e
 				return fields[i].Name > fields[j].Name
 			})
 
-			objectName := p.outputObjectGQLName(file, t)
 			objectConfig, err := file.Config.ObjectConfig(objectName)
 
 			if err != nil {
 				return errors.Wrap(err, "failed to get object config "+objectName)
 			}
 
-			dataLoaderFields, err := p.dataLoaderFields(objectConfig.DataLoaders, t)
+			dataLoaderFields, err := p.dataLoaderFields(file, objectConfig.DataLoaders, t)
 			if err != nil {
 				return errors.Wrapf(err, "failed to resolve output object %s data loaders", objectName)
 			}
@@ -114,6 +124,8 @@ func (p *Plugin) fileOutputMessages(file *parsedFile) ([]graphql.OutputObject, e
 				Fields:           fields,
 				MapFields:        mapFields,
 				DataLoaderFields: dataLoaderFields,
+				Node:             objectConfig.Node,
+				Visibility:       objectConfig.Visibility,
 			})
 		case *parser.Array:
 			return handleType(t.ElemType)
@@ -136,22 +148,32 @@ func (p *Plugin) fileOutputMessages(file *parsedFile) ([]graphql.OutputObject, e
 	return res, nil
 }
 
-func (p *Plugin) dataLoaderFields(configs []dataloader.FieldConfig, object *parser.Object) ([]*graphql.DataLoaderField, error) {
+func (p *Plugin) dataLoaderFields(file *parsedFile, configs []dataloader.FieldConfig, object *parser.Object) ([]*graphql.DataLoaderField, error) {
 	var fields []*graphql.DataLoaderField
 
 	for _, cfg := range configs {
-		prop := object.GetPropertyByName(cfg.KeyFieldName)
+		if cfg.KeyFieldName != "" && len(cfg.KeyFieldNames) > 0 {
+			return nil, errors.Errorf("key_field_name and key_field_names can't be used together in %s data loader field", cfg.FieldName)
+		}
 
-		if prop == nil {
-			return nil, errors.Errorf("Can't find property %s for dataloader", cfg.KeyFieldName)
+		var keyFields []graphql.DataLoaderKeyField
+		for _, keyFieldPath := range cfg.KeyFields() {
+			keyField, err := p.dataLoaderKeyField(file, object, keyFieldPath)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to resolve %s data loader field key", cfg.FieldName)
+			}
+
+			keyFields = append(keyFields, *keyField)
 		}
 
 		field := &graphql.DataLoaderField{
 			Name:                         cfg.FieldName,
-			ParentKeyFieldName:           cfg.KeyFieldName,
-			KeyFieldSlice:                prop.Type.Kind() == parser.KindArray,
-			NormalizedParentKeyFieldName: pascalize(cfg.KeyFieldName),
+			ParentKeyFieldName:           strings.Join(cfg.KeyFields(), ","),
+			KeyFieldSlice:                len(keyFields) == 1 && keyFields[0].Repeated,
+			NormalizedParentKeyFieldName: pascalize(cfg.KeyFields()[0]),
 			DataLoaderName:               cfg.DataLoader,
+			KeyFields:                    keyFields,
+			Args:                         cfg.FieldArgs(),
 		}
 
 		fields = append(fields, field)
@@ -159,3 +181,64 @@ func (p *Plugin) dataLoaderFields(configs []dataloader.FieldConfig, object *pars
 
 	return fields, nil
 }
+
+func (p *Plugin) dataLoaderKeyField(file *parsedFile, object *parser.Object, path string) (*graphql.DataLoaderKeyField, error) {
+	pathParts := strings.Split(path, ".")
+	obj := object
+	var prop *parser.ObjectProperty
+	for i, part := range pathParts {
+		prop = obj.GetPropertyByName(part)
+
+		if prop == nil {
+			return nil, errors.Errorf("Can't find property %s for dataloader", path)
+		}
+
+		if i == len(pathParts)-1 {
+			break
+		}
+
+		propObj, ok := prop.Type.(*parser.Object)
+		if !ok {
+			return nil, errors.Errorf("property %s of %s is not an object", part, path)
+		}
+		obj = propObj
+	}
+
+	keyType := prop.Type
+	arr, repeated := keyType.(*parser.Array)
+	if repeated {
+		keyType = arr.ElemType
+	}
+
+	keyGoType, err := p.goTypeByParserType(file, keyType, false)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to resolve property %s go type", path)
+	}
+
+	valueGoType, err := p.goTypeByParserType(file, prop.Type, false)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to resolve property %s go type", path)
+	}
+
+	return &graphql.DataLoaderKeyField{
+		Path:     path,
+		GoType:   keyGoType,
+		Repeated: repeated,
+		Value: func(arg string, ctx graphql.BodyContext) string {
+			if len(pathParts) == 1 {
+				return arg + "." + pascalize(path)
+			}
+
+			// Nested objects are pointers, so we must check them to nil.
+			valueType := valueGoType.String(ctx.Importer)
+			res := "func() " + valueType + " {\n"
+			accessor := arg
+			for _, part := range pathParts[:len(pathParts)-1] {
+				accessor += "." + pascalize(part)
+				res += "if " + accessor + " == nil {\nvar zero " + valueType + "\nreturn zero\n}\n"
+			}
+
+			return res + "return " + accessor + "." + pascalize(pathParts[len(pathParts)-1]) + "\n}()"
+		},
+	}, nil
+}
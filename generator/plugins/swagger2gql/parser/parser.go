@@ -3,6 +3,7 @@ package parser
 import (
 	"io"
 	"io/ioutil"
+	"sort"
 	"strings"
 
 	"github.com/go-openapi/spec"
@@ -150,7 +151,13 @@ func (p *fileParser) resolveSchemaType(route []string, schema *spec.Schema) (Typ
 		for _, requiredField := range schema.Required {
 			requiredFields[requiredField] = struct{}{}
 		}
-		for name, prop := range schema.Properties {
+		propsNames := make([]string, 0, len(schema.Properties))
+		for name := range schema.Properties {
+			propsNames = append(propsNames, name)
+		}
+		sort.Strings(propsNames)
+		for _, name := range propsNames {
+			prop := schema.Properties[name]
 			_, required := requiredFields[name]
 			ptyp, err := p.resolveSchemaType(append(route, name), &prop)
 			if err != nil {
@@ -209,7 +216,13 @@ func (p *fileParser) parseMethodParams(method *spec.Operation) ([]MethodParamete
 }
 func (p *fileParser) parseMethodResponses(method *spec.Operation) ([]MethodResponse, error) {
 	var res []MethodResponse
-	for statusCode, response := range method.Responses.StatusCodeResponses {
+	statusCodes := make([]int, 0, len(method.Responses.StatusCodeResponses))
+	for statusCode := range method.Responses.StatusCodeResponses {
+		statusCodes = append(statusCodes, statusCode)
+	}
+	sort.Ints(statusCodes)
+	for _, statusCode := range statusCodes {
+		response := method.Responses.StatusCodeResponses[statusCode]
 		typ, err := p.resolveSchemaType([]string{method.ID}, response.Schema)
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to resolve schema type")
@@ -224,24 +237,38 @@ func (p *fileParser) parseMethodResponses(method *spec.Operation) ([]MethodRespo
 }
 func (p *fileParser) parseTags() error {
 	var tagsByName = make(map[string]*Tag)
+	var tagsNames []string // tags are returned in order of declaration
 	for _, tag := range p.schema.Tags {
+		if _, ok := tagsByName[tag.Name]; !ok {
+			tagsNames = append(tagsNames, tag.Name)
+		}
 		tagsByName[tag.Name] = &Tag{
 			Name:        tag.Name,
 			Description: tag.Description,
 		}
 	}
 	if p.schema.Paths != nil {
-		for path, pathItems := range p.schema.Paths.Paths {
-			methods := map[string]*spec.Operation{
-				"GET":     pathItems.Get,
-				"PUT":     pathItems.Put,
-				"POST":    pathItems.Post,
-				"DELETE":  pathItems.Delete,
-				"OPTIONS": pathItems.Options,
-				"HEAD":    pathItems.Head,
-				"PATCH":   pathItems.Patch,
+		paths := make([]string, 0, len(p.schema.Paths.Paths))
+		for path := range p.schema.Paths.Paths {
+			paths = append(paths, path)
+		}
+		sort.Strings(paths)
+		for _, path := range paths {
+			pathItems := p.schema.Paths.Paths[path]
+			methods := []struct {
+				httpMethod string
+				operation  *spec.Operation
+			}{
+				{"GET", pathItems.Get},
+				{"PUT", pathItems.Put},
+				{"POST", pathItems.Post},
+				{"DELETE", pathItems.Delete},
+				{"OPTIONS", pathItems.Options},
+				{"HEAD", pathItems.Head},
+				{"PATCH", pathItems.Patch},
 			}
-			for httpMethod, method := range methods {
+			for _, op := range methods {
+				httpMethod, method := op.httpMethod, op.operation
 				if method == nil {
 					continue
 				}
@@ -273,6 +300,7 @@ func (p *fileParser) parseTags() error {
 							Name: tag,
 						}
 						tagsByName[tag] = t
+						tagsNames = append(tagsNames, tag)
 					}
 					t.Methods = append(t.Methods, m)
 				}
@@ -280,8 +308,8 @@ func (p *fileParser) parseTags() error {
 		}
 	}
 	var res []Tag
-	for _, tag := range tagsByName {
-		res = append(res, *tag)
+	for _, tagName := range tagsNames {
+		res = append(res, *tagsByName[tagName])
 	}
 	p.result.Tags = res
 	return nil
@@ -5,13 +5,11 @@ import (
 	"path/filepath"
 	"strings"
 
-	"github.com/mitchellh/mapstructure"
 	"github.com/pkg/errors"
 
 	"github.com/EGT-Ukraine/go2gql/generator"
 	"github.com/EGT-Ukraine/go2gql/generator/plugins/dataloader"
 	"github.com/EGT-Ukraine/go2gql/generator/plugins/graphql"
-	"github.com/EGT-Ukraine/go2gql/generator/plugins/graphql/lib/pluginconfig"
 	"github.com/EGT-Ukraine/go2gql/generator/plugins/swagger2gql/parser"
 )
 
@@ -26,6 +24,7 @@ type Plugin struct {
 	dataLoaderPlugin *dataloader.Plugin
 	config           *Config
 	generateConfig   *generator.GenerateConfig
+	parsedFiles      []*parsedFile
 }
 
 func (p *Plugin) Init(config *generator.GenerateConfig, plugins []generator.Plugin) error {
@@ -44,7 +43,7 @@ func (p *Plugin) Init(config *generator.GenerateConfig, plugins []generator.Plug
 		return errors.New("'dataloader' plugin is not installed")
 	}
 	cfg := new(Config)
-	err := mapstructure.Decode(config.PluginsConfigs[PluginConfigKey], cfg)
+	err := config.DecodePluginConfig(PluginConfigKey, cfg)
 	if err != nil {
 		return errors.Wrap(err, "failed to decode config")
 	}
@@ -61,7 +60,7 @@ func (p *Plugin) Init(config *generator.GenerateConfig, plugins []generator.Plug
 func (p *Plugin) parseImports() error {
 	for _, pluginsConfigsImports := range p.generateConfig.PluginsConfigsImports {
 		configs := new([]*SwaggerFileConfig)
-		if err := pluginconfig.Decode(pluginsConfigsImports.PluginsConfigs[PluginImportConfigKey], configs); err != nil {
+		if err := pluginsConfigsImports.DecodePluginConfig(PluginImportConfigKey, configs); err != nil {
 			return errors.Wrap(err, "failed to decode config")
 		}
 
@@ -163,6 +162,7 @@ func (p *Plugin) prepareTypesFile(file *parsedFile) (*graphql.TypesFile, error)
 		return nil, errors.Wrap(err, "failed to prepare file services")
 	}
 	res := &graphql.TypesFile{
+		Source:                  file.Config.Path,
 		PackageName:             file.OutputPkgName,
 		Package:                 file.OutputPkg,
 		InputObjects:            inputs,
@@ -178,8 +178,14 @@ func (p *Plugin) prepareTypesFile(file *parsedFile) (*graphql.TypesFile, error)
 }
 
 func (p *Plugin) Prepare() error {
+	if err := graphql.ValidateInt64Format(p.config.GetInt64Format()); err != nil {
+		return err
+	}
 	parser := parser.Parser{}
 	for _, cfg := range p.config.Files {
+		if err := graphql.ValidateInt64Format(cfg.Int64Format); err != nil {
+			return errors.Wrapf(err, "invalid file %s config", cfg.Path)
+		}
 		file, err := os.Open(cfg.Path)
 		if err != nil {
 			return errors.Wrap(err, "failed to open file")
@@ -214,15 +220,50 @@ func (p *Plugin) Prepare() error {
 			return errors.Wrap(err, "failed to prepare types cfg")
 		}
 		p.graphql.AddTypesFile(outPath, gqlFile)
+		p.parsedFiles = append(p.parsedFiles, f)
 	}
 
 	return nil
 }
 
+// Sources returns configured swagger files.
+func (p *Plugin) Sources() []string {
+	var res []string
+	if p.config != nil {
+		for _, file := range p.config.Files {
+			res = append(res, file.Path)
+		}
+	}
+
+	return res
+}
+
+// OutputRoots returns configured output paths.
+func (p *Plugin) OutputRoots() []string {
+	if p.config == nil {
+		return nil
+	}
+	res := []string{p.config.OutputPath}
+	for _, file := range p.config.Files {
+		res = append(res, file.OutputPath)
+	}
+
+	return res
+}
+
 func (Plugin) Name() string {
 	return PluginName
 }
 
+// Templates returns names of plugin templates, which could be overridden.
+func (Plugin) Templates() []string {
+	return generator.TemplatesNames(AssetNames())
+}
+
+func (Plugin) Dependencies() []string {
+	return []string{graphql.PluginName, dataloader.PluginName}
+}
+
 func (Plugin) Generate() error {
 	return nil
 }
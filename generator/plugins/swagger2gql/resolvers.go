@@ -19,13 +19,55 @@ var scalarsResolvers = map[parser.Kind]graphql.TypeResolver{
 	parser.KindDateTime: graphql.GqlStringTypeResolver,
 }
 
+// int64StringScalarsResolvers are resolvers of 64-bit integers scalars in string int64 format.
+var int64StringScalarsResolvers = map[parser.Kind]graphql.TypeResolver{
+	parser.KindInt64: graphql.GqlInt64StringTypeResolver,
+}
+
+// int64Format returns 64-bit integers format of swagger file.
+func (p *Plugin) int64Format(file *parsedFile) string {
+	if file.Config.Int64Format != "" {
+		return file.Config.Int64Format
+	}
+
+	return p.config.GetInt64Format()
+}
+
+// scalarTypeResolver returns GraphQL type resolver of scalar. 64-bit integers are resolved to string scalars in string format.
+func scalarTypeResolver(scalar *parser.Scalar, int64Format string) (graphql.TypeResolver, error) {
+	if err := graphql.ValidateInt64Format(int64Format); err != nil {
+		return nil, err
+	}
+	if int64Format == graphql.Int64FormatString {
+		if resolver, ok := int64StringScalarsResolvers[scalar.Kind()]; ok {
+			return resolver, nil
+		}
+	}
+	resolver, ok := scalarsResolvers[scalar.Kind()]
+	if !ok {
+		return nil, errors.Errorf("unimplemented scalar type: %s", scalar.Kind())
+	}
+
+	return resolver, nil
+}
+
+// propertyTypeResolver returns type resolver of scalar property with int64_format field config, or resolver as is.
+func propertyTypeResolver(cfg FieldConfig, typ parser.Type, resolver graphql.TypeResolver) (graphql.TypeResolver, error) {
+	scalar, ok := typ.(*parser.Scalar)
+	if !ok || cfg.Int64Format == "" {
+		return resolver, nil
+	}
+
+	return scalarTypeResolver(scalar, cfg.Int64Format)
+}
+
 func (p *Plugin) TypeOutputTypeResolver(typeFile *parsedFile, typ parser.Type, required bool) (graphql.TypeResolver, error) {
 	var res graphql.TypeResolver
 	switch t := typ.(type) {
 	case *parser.Scalar:
-		resolver, ok := scalarsResolvers[typ.Kind()]
-		if !ok {
-			return nil, errors.Errorf(": %s", typ.Kind())
+		resolver, err := scalarTypeResolver(t, p.int64Format(typeFile))
+		if err != nil {
+			return nil, err
 		}
 		res = resolver
 	case *parser.Object:
@@ -52,11 +94,7 @@ func (p *Plugin) TypeOutputTypeResolver(typeFile *parsedFile, typ parser.Type, r
 func (p *Plugin) TypeInputTypeResolver(typeFile *parsedFile, typ parser.Type) (graphql.TypeResolver, error) {
 	switch t := typ.(type) {
 	case *parser.Scalar:
-		resolver, ok := scalarsResolvers[t.Kind()]
-		if !ok {
-			return nil, errors.Errorf("unimplemented scalar type: %s", t.Kind())
-		}
-		return resolver, nil
+		return scalarTypeResolver(t, p.int64Format(typeFile))
 	case *parser.Object:
 		return p.inputObjectTypeResolver(typeFile, t), nil
 	case *parser.Array:
@@ -13,7 +13,6 @@ import (
 	"github.com/EGT-Ukraine/go2gql/generator/plugins/dataloader"
 	"github.com/EGT-Ukraine/go2gql/generator/plugins/graphql"
 	"github.com/EGT-Ukraine/go2gql/generator/plugins/graphql/lib/importer"
-	"github.com/EGT-Ukraine/go2gql/generator/plugins/graphql/lib/names"
 	"github.com/EGT-Ukraine/go2gql/generator/plugins/swagger2gql/parser"
 )
 
@@ -43,14 +42,12 @@ func (p *Plugin) graphqlMethod(tagCfg TagConfig, methodCfg MethodConfig, file *p
 	gqlInputObjName := p.methodParamsInputObjectGQLName(file, method)
 	var args []graphql.MethodArgument
 	for _, param := range method.Parameters {
-		gqlName := names.FilterNotSupportedFieldNameCharacters(param.Name)
-
 		paramCfg, err := file.Config.FieldConfig(gqlInputObjName, param.Name)
 		if err != nil {
 			return nil, errors.Wrapf(err, "failed to resolve property %s config", param.Name)
 		}
 
-		if paramCfg.ContextKey != "" {
+		if paramCfg.ContextKey != "" || paramCfg.Exclude {
 			continue
 		}
 		paramType, err := p.TypeInputTypeResolver(file, param.Type)
@@ -59,9 +56,10 @@ func (p *Plugin) graphqlMethod(tagCfg TagConfig, methodCfg MethodConfig, file *p
 		}
 
 		args = append(args, graphql.MethodArgument{
-			Name:          gqlName,
+			Name:          paramCfg.graphQLName(param.Name),
 			Type:          paramType,
-			QuotedComment: strconv.Quote(param.Description),
+			QuotedComment: paramCfg.quotedComment(param.Description),
+			Visibility:    paramCfg.Visibility,
 		})
 	}
 	reqType := graphql.GoType{
@@ -108,6 +106,8 @@ func (p *Plugin) graphqlMethod(tagCfg TagConfig, methodCfg MethodConfig, file *p
 		RequestType:          reqType,
 		PayloadErrorChecker:  nil,
 		PayloadErrorAccessor: nil,
+		Auth:                 methodCfg.Auth,
+		Visibility:           methodCfg.Visibility,
 	}, nil
 }
 
@@ -124,6 +124,10 @@ func (p *Plugin) addDataLoaderProvider(
 		return nil
 	}
 
+	if methodCfg.Auth != nil {
+		return errors.New("data loader provider method can't have auth policy, because data loaders call method without interceptors")
+	}
+
 	resType, ok := successResponseResultType.(*parser.Array)
 
 	if !ok {
@@ -183,7 +187,7 @@ func (p *Plugin) addDataLoaderProvider(
 			Name:          p.tagName(tag, &tagCfg),
 			CallInterface: p.serviceCallInterface(&tagCfg),
 		},
-		FetchCode: func(importer *importer.Importer) string {
+		FetchCode: func(importer *importer.Importer, args []dataloader.Arg) string {
 			elemType := graphql.GoType{
 				Kind: reflect.Interface,
 				Pkg:  tagCfg.ClientGoPackage,
@@ -213,6 +217,10 @@ func (p *Plugin) addDataLoaderProvider(
 		OutputGoType:      responseGoType,
 		OutputGraphqlType: dataLoaderOutType,
 		Slice:             dataLoaderProviderConfig.Slice,
+		MaxBatch:          dataLoaderProviderConfig.MaxBatch,
+		Cache:             dataLoaderProviderConfig.Cache,
+		CacheTTL:          dataLoaderProviderConfig.CacheTTL,
+		Lazy:              dataLoaderProviderConfig.Lazy,
 	}
 
 	p.dataLoaderPlugin.AddLoader(dataLoaderProvider)
@@ -16,17 +16,13 @@ func (p *Plugin) renderArrayValueResolver(
 	elemResolver graphql.ValueResolver,
 	elemResolverWithErr bool) (string, error) {
 
-	tplBody, err := templatesValue_resolver_arrayGohtmlBytes()
-	if err != nil {
-		panic(errors.Wrap(err, "failed to get array value resolver template").Error())
-	}
-	tpl, err := template.New("array_value_resolver").Funcs(template.FuncMap{
+	tpl, err := p.generateConfig.ParseTemplate("value_resolver_array.gohtml", Asset, template.FuncMap{
 		"errorsPkg": func() string {
 			return ctx.Importer.New(graphql.ErrorsPkgPath)
 		},
-	}).Parse(string(tplBody))
+	})
 	if err != nil {
-		panic(errors.Wrap(err, "failed to parse array value resolver template"))
+		return "", errors.Wrap(err, "failed to parse template")
 	}
 	res := new(bytes.Buffer)
 	err = tpl.Execute(res, map[string]interface{}{
@@ -42,11 +38,7 @@ func (p *Plugin) renderArrayValueResolver(
 }
 
 func (p *Plugin) renderPtrDatetimeResolver(arg string, ctx graphql.BodyContext) (string, error) {
-	tplBody, err := templatesValue_resolver_ptr_datetimeGohtmlBytes()
-	if err != nil {
-		panic(errors.Wrap(err, "failed to get array value resolver template").Error())
-	}
-	tpl, err := template.New("array_value_resolver").Funcs(template.FuncMap{
+	tpl, err := p.generateConfig.ParseTemplate("value_resolver_ptr_datetime.gohtml", Asset, template.FuncMap{
 		"strfmtPkg": func() string {
 			return ctx.Importer.New(strFmtPkg)
 		},
@@ -56,9 +48,9 @@ func (p *Plugin) renderPtrDatetimeResolver(arg string, ctx graphql.BodyContext)
 		"timePkg": func() string {
 			return ctx.Importer.New(timePkg)
 		},
-	}).Parse(string(tplBody))
+	})
 	if err != nil {
-		panic(errors.Wrap(err, "failed to parse array value resolver template"))
+		return "", errors.Wrap(err, "failed to parse template")
 	}
 	res := new(bytes.Buffer)
 	err = tpl.Execute(res, map[string]interface{}{
@@ -68,11 +60,7 @@ func (p *Plugin) renderPtrDatetimeResolver(arg string, ctx graphql.BodyContext)
 }
 
 func (p *Plugin) renderDatetimeValueResolverTemplate(arg string, ctx graphql.BodyContext) (string, error) {
-	tplBody, err := templatesValue_resolver_datetimeGohtmlBytes()
-	if err != nil {
-		panic(errors.Wrap(err, "failed to get array value resolver template").Error())
-	}
-	tpl, err := template.New("array_value_resolver").Funcs(template.FuncMap{
+	tpl, err := p.generateConfig.ParseTemplate("value_resolver_datetime.gohtml", Asset, template.FuncMap{
 		"strfmtPkg": func() string {
 			return ctx.Importer.New(strFmtPkg)
 		},
@@ -82,9 +70,9 @@ func (p *Plugin) renderDatetimeValueResolverTemplate(arg string, ctx graphql.Bod
 		"timePkg": func() string {
 			return ctx.Importer.New(timePkg)
 		},
-	}).Parse(string(tplBody))
+	})
 	if err != nil {
-		panic(errors.Wrap(err, "failed to parse array value resolver template"))
+		return "", errors.Wrap(err, "failed to parse template")
 	}
 	res := new(bytes.Buffer)
 	err = tpl.Execute(res, map[string]interface{}{
@@ -94,13 +82,9 @@ func (p *Plugin) renderDatetimeValueResolverTemplate(arg string, ctx graphql.Bod
 }
 
 func (p *Plugin) renderMethodCaller(responseType, requestType, requestVar, clientVar, methodName string) (string, error) {
-	tplBody, err := templatesMethod_callerGohtmlBytes()
-	if err != nil {
-		panic(errors.Wrap(err, "failed to get array value resolver template").Error())
-	}
-	tpl, err := template.New("array_value_resolver").Parse(string(tplBody))
+	tpl, err := p.generateConfig.ParseTemplate("method_caller.gohtml", Asset, nil)
 	if err != nil {
-		panic(errors.Wrap(err, "failed to parse array value resolver template"))
+		return "", errors.Wrap(err, "failed to parse template")
 	}
 	res := new(bytes.Buffer)
 	err = tpl.Execute(res, map[string]interface{}{
@@ -114,13 +98,9 @@ func (p *Plugin) renderMethodCaller(responseType, requestType, requestVar, clien
 }
 
 func (p *Plugin) renderNullMethodCaller(requestType, requestVar, clientVar, methodName string) (string, error) {
-	tplBody, err := templatesMethod_caller_nullGohtmlBytes()
-	if err != nil {
-		panic(errors.Wrap(err, "failed to get array value resolver template").Error())
-	}
-	tpl, err := template.New("array_value_resolver").Parse(string(tplBody))
+	tpl, err := p.generateConfig.ParseTemplate("method_caller_null.gohtml", Asset, nil)
 	if err != nil {
-		panic(errors.Wrap(err, "failed to parse array value resolver template"))
+		return "", errors.Wrap(err, "failed to parse template")
 	}
 	res := new(bytes.Buffer)
 	err = tpl.Execute(res, map[string]interface{}{
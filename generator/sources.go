@@ -0,0 +1,54 @@
+package generator
+
+import (
+	"os"
+	"path/filepath"
+)
+
+// PluginWithSources is optional interface of plugin, which reads source files(e.g. proto or swagger files).
+type PluginWithSources interface {
+	// Sources returns paths of files, which were read by plugin.
+	// Files, which failed to parse, should be returned too.
+	Sources() []string
+}
+
+// Sources returns absolute paths of config, config imports, plugins sources and templates overrides files.
+// Templates overrides paths are returned even if they don't exist, so adding override could be noticed.
+func (g *Generator) Sources() []string {
+	var res []string
+	seen := make(map[string]struct{})
+	add := func(path string) {
+		if path == "" {
+			return
+		}
+		if abs, err := filepath.Abs(path); err == nil {
+			path = abs
+		}
+		if _, ok := seen[path]; ok {
+			return
+		}
+		seen[path] = struct{}{}
+		res = append(res, path)
+	}
+
+	if g.Config != nil {
+		add(g.Config.Path)
+		for _, imp := range g.Config.PluginsConfigsImports {
+			add(imp.Path)
+		}
+	}
+	for _, plugin := range g.Plugins {
+		if p, ok := plugin.(PluginWithSources); ok {
+			for _, source := range p.Sources() {
+				add(source)
+			}
+		}
+		if p, ok := plugin.(PluginWithTemplates); ok && g.Config != nil && g.Config.TemplatesDir != "" {
+			for _, name := range p.Templates() {
+				add(filepath.Join(os.ExpandEnv(g.Config.TemplatesDir), name))
+			}
+		}
+	}
+
+	return res
+}
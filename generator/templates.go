@@ -0,0 +1,211 @@
+package generator
+
+import (
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+	"text/template/parse"
+
+	"github.com/pkg/errors"
+)
+
+// TemplatesContextVersion is a version of data, which is passed to plugins templates. It's increased
+// on every backward incompatible change of templates contexts(e.g. when field is removed or renamed),
+// so users have to review their overridden templates and bump `templates_version` config value.
+const TemplatesContextVersion = 2
+
+const templatesExt = ".gohtml"
+
+// PluginWithTemplates is optional interface of plugin, which renders code from templates,
+// that could be overridden by files of `templates_dir`.
+type PluginWithTemplates interface {
+	// Templates returns names of plugin templates(e.g. types_body.gohtml).
+	Templates() []string
+}
+
+// Template is a parsed plugin template.
+type Template struct {
+	*template.Template
+	OverridePath string // path of template override file. Empty, if embedded template is used
+}
+
+// Execute applies template to data. Errors of overridden templates point to the override file,
+// because they usually mean, that override references fields, which don't exist in template context.
+func (t *Template) Execute(w io.Writer, data interface{}) error {
+	err := t.Template.Execute(w, data)
+	if err != nil && t.OverridePath != "" {
+		return errors.Wrapf(err, "failed to execute template override %s (templates context version %d). "+
+			"Check, that it references only existing template context fields", t.OverridePath, TemplatesContextVersion)
+	}
+
+	return err
+}
+
+// ReferencesField reports, whether template references context field with name, e.g. `{{ if $method.Auth }}`.
+// Plugins use it to check, that overrides still render code, which depends on field.
+func (t *Template) ReferencesField(name string) bool {
+	for _, tpl := range t.Templates() {
+		if tpl.Tree != nil && nodeReferencesField(tpl.Tree.Root, name) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func nodeReferencesField(node parse.Node, name string) bool {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return false
+		}
+		for _, child := range n.Nodes {
+			if nodeReferencesField(child, name) {
+				return true
+			}
+		}
+	case *parse.ActionNode:
+		return nodeReferencesField(n.Pipe, name)
+	case *parse.IfNode:
+		return branchReferencesField(n.BranchNode, name)
+	case *parse.RangeNode:
+		return branchReferencesField(n.BranchNode, name)
+	case *parse.WithNode:
+		return branchReferencesField(n.BranchNode, name)
+	case *parse.TemplateNode:
+		return nodeReferencesField(n.Pipe, name)
+	case *parse.PipeNode:
+		if n == nil {
+			return false
+		}
+		for _, cmd := range n.Cmds {
+			if nodeReferencesField(cmd, name) {
+				return true
+			}
+		}
+	case *parse.CommandNode:
+		for _, arg := range n.Args {
+			if nodeReferencesField(arg, name) {
+				return true
+			}
+		}
+	case *parse.FieldNode:
+		return containsIdent(n.Ident, name)
+	case *parse.VariableNode:
+		return containsIdent(n.Ident[1:], name)
+	case *parse.ChainNode:
+		return containsIdent(n.Field, name) || nodeReferencesField(n.Node, name)
+	}
+
+	return false
+}
+
+func branchReferencesField(branch parse.BranchNode, name string) bool {
+	return nodeReferencesField(branch.Pipe, name) ||
+		nodeReferencesField(branch.List, name) ||
+		nodeReferencesField(branch.ElseList, name)
+}
+
+func containsIdent(idents []string, name string) bool {
+	for _, ident := range idents {
+		if ident == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseTemplate parses plugin template by name. If `templates_dir` contains file with the same name,
+// it's used instead of embedded template. asset should return embedded template by path(go-bindata Asset func).
+func (gc *GenerateConfig) ParseTemplate(name string, asset func(path string) ([]byte, error), funcs template.FuncMap) (*Template, error) {
+	res := &Template{
+		Template: template.New(name).Funcs(funcs),
+	}
+	body, err := asset("templates/" + name)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get template %s", name)
+	}
+	if gc.TemplatesDir != "" {
+		path := filepath.Join(os.ExpandEnv(gc.TemplatesDir), name)
+		override, err := ioutil.ReadFile(path)
+		if err != nil && !os.IsNotExist(err) {
+			return nil, errors.Wrapf(err, "failed to read template override %s", path)
+		}
+		if err == nil {
+			body = override
+			res.OverridePath = path
+			// maps contexts values could be missed silently otherwise
+			res.Option("missingkey=error")
+		}
+	}
+	if _, err := res.Parse(string(body)); err != nil {
+		if res.OverridePath != "" {
+			return nil, errors.Wrapf(err, "failed to parse template override %s", res.OverridePath)
+		}
+
+		return nil, errors.Wrapf(err, "failed to parse template %s", name)
+	}
+
+	return res, nil
+}
+
+// validateTemplates checks, that templates overrides are written for current templates context version
+// and that each of them overrides some plugin template.
+func (g *Generator) validateTemplates() error {
+	if g.Config.TemplatesDir == "" {
+		return nil
+	}
+	dir := os.ExpandEnv(g.Config.TemplatesDir)
+	if g.Config.TemplatesVersion != TemplatesContextVersion {
+		return errors.Errorf("templates from %s are written for templates context version %d, but current version is %d. "+
+			"Review overridden templates and set `templates_version: %d`",
+			dir, g.Config.TemplatesVersion, TemplatesContextVersion, TemplatesContextVersion)
+	}
+
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return errors.Wrap(err, "failed to read templates directory")
+	}
+	templates := make(map[string]bool)
+	for _, plugin := range g.Plugins {
+		if p, ok := plugin.(PluginWithTemplates); ok {
+			for _, name := range p.Templates() {
+				templates[name] = true
+			}
+		}
+	}
+	var unknown []string
+	for _, file := range files {
+		if file.IsDir() || filepath.Ext(file.Name()) != templatesExt {
+			continue
+		}
+		if !templates[file.Name()] {
+			unknown = append(unknown, filepath.Join(dir, file.Name()))
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+
+		return errors.Errorf("templates overrides don't match any plugin template:\n\t%s", strings.Join(unknown, "\n\t"))
+	}
+
+	return nil
+}
+
+// TemplatesNames returns names of templates, embedded with go-bindata, by assets paths.
+func TemplatesNames(assets []string) []string {
+	var res []string
+	for _, asset := range assets {
+		if strings.HasPrefix(asset, "templates/") && filepath.Ext(asset) == templatesExt {
+			res = append(res, strings.TrimPrefix(asset, "templates/"))
+		}
+	}
+	sort.Strings(res)
+
+	return res
+}
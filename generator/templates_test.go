@@ -0,0 +1,101 @@
+package generator
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pkg/errors"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+type templatesTestPlugin struct {
+	testPlugin
+}
+
+func (templatesTestPlugin) Templates() []string { return []string{"body.gohtml"} }
+
+func TestTemplates(t *testing.T) {
+	Convey("Test templates overrides", t, func() {
+		dir, err := ioutil.TempDir("", "go2gql-templates")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(dir)
+
+		asset := func(path string) ([]byte, error) {
+			if path != "templates/body.gohtml" {
+				return nil, errors.Errorf("asset %s not found", path)
+			}
+
+			return []byte("embedded {{.Name}}"), nil
+		}
+		type context struct {
+			Name string
+		}
+		cfg := &GenerateConfig{
+			TemplatesDir:     dir,
+			TemplatesVersion: TemplatesContextVersion,
+		}
+		execute := func() (string, error) {
+			tpl, err := cfg.ParseTemplate("body.gohtml", asset, nil)
+			if err != nil {
+				return "", err
+			}
+			buf := new(bytes.Buffer)
+			err = tpl.Execute(buf, context{Name: "a"})
+
+			return buf.String(), err
+		}
+		override := func(body string) {
+			So(ioutil.WriteFile(filepath.Join(dir, "body.gohtml"), []byte(body), 0666), ShouldBeNil)
+		}
+		g := &Generator{
+			Config:  cfg,
+			Plugins: []Plugin{templatesTestPlugin{testPlugin{name: "test"}}},
+		}
+
+		Convey("Should use embedded template, if it's not overridden", func() {
+			res, err := execute()
+			So(err, ShouldBeNil)
+			So(res, ShouldEqual, "embedded a")
+		})
+		Convey("Should use template override", func() {
+			override("override {{.Name}}")
+			res, err := execute()
+			So(err, ShouldBeNil)
+			So(res, ShouldEqual, "override a")
+			So(g.validateTemplates(), ShouldBeNil)
+		})
+		Convey("Should point to override, which references missing field", func() {
+			override("override {{.Title}}")
+			_, err := execute()
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldContainSubstring, "failed to execute template override "+filepath.Join(dir, "body.gohtml"))
+			So(err.Error(), ShouldContainSubstring, "can't evaluate field Title")
+		})
+		Convey("Should find context fields references", func() {
+			references := func(body string) bool {
+				override(body)
+				tpl, err := cfg.ParseTemplate("body.gohtml", asset, nil)
+				So(err, ShouldBeNil)
+
+				return tpl.ReferencesField("Auth")
+			}
+			So(references(`{{.Auth.Roles}}`), ShouldBeTrue)
+			So(references(`{{range $m := .Methods}}{{if $m.Auth}}auth{{end}}{{end}}`), ShouldBeTrue)
+			So(references(`{{define "check"}}{{with .Auth}}auth{{end}}{{end}}{{template "check" .}}`), ShouldBeTrue)
+			So(references(`{{.Author}}{{/* .Auth */}}`), ShouldBeFalse)
+		})
+		Convey("Should fail on outdated templates version", func() {
+			cfg.TemplatesVersion = TemplatesContextVersion - 1
+			So(g.validateTemplates(), ShouldNotBeNil)
+		})
+		Convey("Should fail on overrides, which don't match plugins templates", func() {
+			So(ioutil.WriteFile(filepath.Join(dir, "boody.gohtml"), nil, 0666), ShouldBeNil)
+			err := g.validateTemplates()
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldContainSubstring, filepath.Join(dir, "boody.gohtml"))
+		})
+	})
+}
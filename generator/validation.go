@@ -0,0 +1,21 @@
+package generator
+
+import (
+	"strings"
+)
+
+// PluginWithConfigValidation is optional interface of plugin, which validates config after all plugins are prepared.
+type PluginWithConfigValidation interface {
+	// ValidateConfig returns UnmatchedConfigEntriesError, if config entries(e.g. services, messages regexes)
+	// matched nothing in plugin sources.
+	ValidateConfig() error
+}
+
+// UnmatchedConfigEntriesError is returned by plugins, when config entries matched nothing.
+type UnmatchedConfigEntriesError struct {
+	Entries []string
+}
+
+func (e UnmatchedConfigEntriesError) Error() string {
+	return "config entries matched nothing:\n\t" + strings.Join(e.Entries, "\n\t")
+}